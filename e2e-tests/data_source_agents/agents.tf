@@ -0,0 +1,21 @@
+data "wazuh_agents" "active" {
+  status = [var.wazuh_agents_status]
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.ids, "000")
+      error_message = "The manager (agent 000) is not listed as an active agent."
+    }
+  }
+}
+
+data "wazuh_agent" "manager" {
+  agent_id = var.wazuh_agent_id
+
+  lifecycle {
+    postcondition {
+      condition     = self.status == "active" && self.version != ""
+      error_message = "Agent ${var.wazuh_agent_id} is not active or reports no version."
+    }
+  }
+}
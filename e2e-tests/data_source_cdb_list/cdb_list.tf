@@ -0,0 +1,10 @@
+data "wazuh_cdb_list" "list" {
+  filename = var.wazuh_cdb_list_filename
+
+  lifecycle {
+    postcondition {
+      condition     = self.entry_count > 0 && length(self.entries) == self.entry_count
+      error_message = "CDB list ${var.wazuh_cdb_list_filename} is empty or was not parsed."
+    }
+  }
+}
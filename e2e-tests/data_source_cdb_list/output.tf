@@ -0,0 +1,3 @@
+output "cdb_list_path" {
+  value = data.wazuh_cdb_list.list.path
+}
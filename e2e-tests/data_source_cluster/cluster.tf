@@ -0,0 +1,20 @@
+data "wazuh_cluster_health" "cluster" {
+  lifecycle {
+    postcondition {
+      condition     = !self.running || self.connected_nodes > 0
+      error_message = "The cluster is running but reports no connected nodes."
+    }
+  }
+}
+
+# /cluster/nodes fails while the cluster is disabled, so only read it when it runs.
+data "wazuh_cluster_nodes" "nodes" {
+  count = data.wazuh_cluster_health.cluster.running ? 1 : 0
+
+  lifecycle {
+    postcondition {
+      condition     = self.master != ""
+      error_message = "The cluster reports no master node."
+    }
+  }
+}
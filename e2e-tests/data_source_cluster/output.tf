@@ -0,0 +1,7 @@
+output "cluster_running" {
+  value = data.wazuh_cluster_health.cluster.running
+}
+
+output "cluster_node_names" {
+  value = one(data.wazuh_cluster_nodes.nodes[*].names)
+}
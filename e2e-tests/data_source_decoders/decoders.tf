@@ -0,0 +1,10 @@
+data "wazuh_decoders" "decoder" {
+  name = var.wazuh_decoder_name
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_decoder_name)
+      error_message = "Decoder ${var.wazuh_decoder_name} not found."
+    }
+  }
+}
@@ -0,0 +1,3 @@
+output "decoder_files" {
+  value = distinct(data.wazuh_decoders.decoder.decoders[*].filename)
+}
@@ -0,0 +1,11 @@
+data "wazuh_fim_files" "file" {
+  agent_id = var.wazuh_agent_id
+  file     = var.wazuh_fim_file
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.files) == 1 && self.files[0].sha256 != ""
+      error_message = "${var.wazuh_fim_file} is not in the FIM database of agent ${var.wazuh_agent_id}."
+    }
+  }
+}
@@ -0,0 +1,7 @@
+output "fim_sha256" {
+  value = data.wazuh_fim_files.file.files[0].sha256
+}
+
+output "fim_last_scan_end" {
+  value = data.wazuh_fim_files.file.last_scan_end
+}
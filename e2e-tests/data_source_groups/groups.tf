@@ -0,0 +1,19 @@
+data "wazuh_groups" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_group_name)
+      error_message = "Group ${var.wazuh_group_name} is not listed."
+    }
+  }
+}
+
+data "wazuh_group" "group" {
+  name = var.wazuh_group_name
+
+  lifecycle {
+    postcondition {
+      condition     = contains([for f in self.files : f.filename], "agent.conf")
+      error_message = "Group ${var.wazuh_group_name} has no agent.conf."
+    }
+  }
+}
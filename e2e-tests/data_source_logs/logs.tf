@@ -0,0 +1,21 @@
+data "wazuh_manager_logs" "recent" {
+  level = var.wazuh_logs_level
+  since = var.wazuh_logs_since
+  limit = 20
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.entries) > 0 && alltrue([for e in self.entries : e.level == var.wazuh_logs_level])
+      error_message = "No ${var.wazuh_logs_level} log entries in the last ${var.wazuh_logs_since}, or entries of other levels were returned."
+    }
+  }
+}
+
+data "wazuh_manager_logs_summary" "manager" {
+  lifecycle {
+    postcondition {
+      condition     = length(self.tags) > 0
+      error_message = "The manager log summary lists no daemons."
+    }
+  }
+}
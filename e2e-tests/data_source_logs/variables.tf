@@ -0,0 +1,37 @@
+variable "wazuh_endpoint" {
+  type        = string
+  default     = "https://localhost:55000"
+  description = "Wazuh URL"
+}
+
+variable "wazuh_api_user" {
+  type        = string
+  default     = "wazuh-wui"
+  description = "Wazuh admin user"
+  sensitive   = true
+}
+
+variable "wazuh_api_password" {
+  type        = string
+  default     = "MyS3cr37P450r.*-"
+  description = "Wazuh password of admin user"
+  sensitive   = true
+}
+
+variable "wazuh_skip_ssl_verify" {
+  type        = bool
+  description = "Whether to skip SSL certificate verification when connecting to the Wazuh API."
+  default     = true
+}
+
+variable "wazuh_logs_level" {
+  type        = string
+  description = "Level of the log entries to read."
+  default     = "info"
+}
+
+variable "wazuh_logs_since" {
+  type        = string
+  description = "Only read entries newer than this duration."
+  default     = "24h"
+}
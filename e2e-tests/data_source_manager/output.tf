@@ -0,0 +1,11 @@
+output "manager_version" {
+  value = data.wazuh_manager_info.manager.version
+}
+
+output "stopped_daemons" {
+  value = data.wazuh_manager_status.manager.stopped
+}
+
+output "api_https_enabled" {
+  value = data.wazuh_manager_api_config.manager.https_enabled
+}
@@ -0,0 +1,3 @@
+output "rule_count" {
+  value = length(data.wazuh_rules.group.ids)
+}
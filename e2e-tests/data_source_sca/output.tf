@@ -0,0 +1,7 @@
+output "sca_policy_ids" {
+  value = data.wazuh_sca_policies.agent.policy_ids
+}
+
+output "sca_failed_check_ids" {
+  value = data.wazuh_sca_checks.first_policy.failed_ids
+}
@@ -0,0 +1,15 @@
+output "user_role_ids" {
+  value = data.wazuh_user.user.role_ids
+}
+
+output "role_id" {
+  value = data.wazuh_role.role.role_id
+}
+
+output "policy_id" {
+  value = data.wazuh_policy.policy.policy_id
+}
+
+output "security_rule_id" {
+  value = data.wazuh_security_rule.rule.rule_id
+}
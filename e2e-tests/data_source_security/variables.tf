@@ -0,0 +1,49 @@
+variable "wazuh_endpoint" {
+  type        = string
+  default     = "https://localhost:55000"
+  description = "Wazuh URL"
+}
+
+variable "wazuh_api_user" {
+  type        = string
+  default     = "wazuh-wui"
+  description = "Wazuh admin user"
+  sensitive   = true
+}
+
+variable "wazuh_api_password" {
+  type        = string
+  default     = "MyS3cr37P450r.*-"
+  description = "Wazuh password of admin user"
+  sensitive   = true
+}
+
+variable "wazuh_skip_ssl_verify" {
+  type        = bool
+  description = "Whether to skip SSL certificate verification when connecting to the Wazuh API."
+  default     = true
+}
+
+variable "wazuh_username" {
+  type        = string
+  description = "Name of the API user to read."
+  default     = "wazuh-wui"
+}
+
+variable "wazuh_role_name" {
+  type        = string
+  description = "Name of the role to read."
+  default     = "administrator"
+}
+
+variable "wazuh_policy_name" {
+  type        = string
+  description = "Name of the policy to read."
+  default     = "agents_all_resourceless"
+}
+
+variable "wazuh_security_rule_name" {
+  type        = string
+  description = "Name of the security rule to read."
+  default     = "wui_elastic_admin"
+}
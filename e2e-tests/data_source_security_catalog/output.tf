@@ -0,0 +1,7 @@
+output "security_action_count" {
+  value = length(data.wazuh_security_actions.all.names)
+}
+
+output "security_resource_names" {
+  value = data.wazuh_security_resources.all.names
+}
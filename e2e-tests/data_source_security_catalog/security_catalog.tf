@@ -0,0 +1,17 @@
+data "wazuh_security_actions" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_security_action)
+      error_message = "RBAC action ${var.wazuh_security_action} is not listed."
+    }
+  }
+}
+
+data "wazuh_security_resources" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_security_resource)
+      error_message = "RBAC resource ${var.wazuh_security_resource} is not listed."
+    }
+  }
+}
@@ -0,0 +1,7 @@
+output "analysisd_events_processed" {
+  value = data.wazuh_manager_daemon_stats.manager.analysisd_events_processed
+}
+
+output "hourly_averages" {
+  value = data.wazuh_manager_stats_hourly.manager.averages
+}
@@ -0,0 +1,20 @@
+data "wazuh_manager_daemon_stats" "manager" {
+  daemons = ["wazuh-analysisd", "wazuh-remoted"]
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.stats) == 2 && alltrue([for s in self.stats : s.uptime != ""])
+      error_message = "wazuh-analysisd and wazuh-remoted statistics were not returned."
+    }
+  }
+}
+
+data "wazuh_manager_stats_hourly" "manager" {
+  lifecycle {
+    postcondition {
+      # A fresh manager has no hourly statistics yet.
+      condition     = contains([0, 24], length(self.averages))
+      error_message = "Expected one hourly average per hour of the day."
+    }
+  }
+}
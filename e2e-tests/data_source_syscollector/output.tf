@@ -0,0 +1,11 @@
+output "os_name" {
+  value = data.wazuh_syscollector_os.agent.os[0].os_name
+}
+
+output "cpu_cores" {
+  value = data.wazuh_syscollector_hardware.agent.hardware[0].cpu_cores
+}
+
+output "package_names" {
+  value = data.wazuh_syscollector_packages.agent.packages[*].name
+}
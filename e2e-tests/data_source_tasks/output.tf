@@ -0,0 +1,3 @@
+output "task_statuses" {
+  value = data.wazuh_tasks.upgrades.statuses
+}
@@ -0,0 +1,10 @@
+data "wazuh_tasks" "upgrades" {
+  module = var.wazuh_tasks_module
+
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for t in self.tasks : t.module == var.wazuh_tasks_module])
+      error_message = "Tasks of other modules were returned."
+    }
+  }
+}
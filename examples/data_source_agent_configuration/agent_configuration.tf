@@ -0,0 +1,12 @@
+data "wazuh_agent_configuration" "syscheck" {
+  agent_id      = var.wazuh_agent_id
+  component     = "syscheck"
+  configuration = "syscheck"
+
+  lifecycle {
+    postcondition {
+      condition     = can(tonumber(self.values["frequency"]))
+      error_message = "Agent ${var.wazuh_agent_id} reports no FIM frequency."
+    }
+  }
+}
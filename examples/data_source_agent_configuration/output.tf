@@ -0,0 +1,7 @@
+output "fim_frequency" {
+  value = data.wazuh_agent_configuration.syscheck.values["frequency"]
+}
+
+output "fim_directories" {
+  value = [for d in jsondecode(data.wazuh_agent_configuration.syscheck.config_json).directories : d.dir]
+}
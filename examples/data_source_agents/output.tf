@@ -0,0 +1,7 @@
+output "active_agent_ids" {
+  value = data.wazuh_agents.active.ids
+}
+
+output "manager_version" {
+  value = data.wazuh_agent.manager.version
+}
@@ -0,0 +1,7 @@
+output "group_names" {
+  value = data.wazuh_groups.all.names
+}
+
+output "group_agent_count" {
+  value = data.wazuh_group.group.agent_count
+}
@@ -0,0 +1,7 @@
+output "recent_log_tags" {
+  value = distinct(data.wazuh_manager_logs.recent.entries[*].tag)
+}
+
+output "log_error_count" {
+  value = data.wazuh_manager_logs_summary.manager.errors
+}
@@ -0,0 +1,26 @@
+data "wazuh_manager_info" "manager" {
+  lifecycle {
+    postcondition {
+      condition     = startswith(self.version, "v4.")
+      error_message = "Unexpected Wazuh manager version ${self.version}."
+    }
+  }
+}
+
+data "wazuh_manager_status" "manager" {
+  lifecycle {
+    postcondition {
+      condition     = lookup(self.daemons, var.wazuh_manager_daemon, "") == "running"
+      error_message = "${var.wazuh_manager_daemon} is not running."
+    }
+  }
+}
+
+data "wazuh_manager_api_config" "manager" {
+  lifecycle {
+    postcondition {
+      condition     = self.port == 55000
+      error_message = "The Wazuh API does not listen on port 55000."
+    }
+  }
+}
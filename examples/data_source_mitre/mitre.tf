@@ -0,0 +1,32 @@
+data "wazuh_mitre_tactics" "tactic" {
+  tactic_ids = [var.wazuh_mitre_tactic_id]
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.tactics[0].technique_ids, var.wazuh_mitre_technique_id)
+      error_message = "Tactic ${var.wazuh_mitre_tactic_id} does not list technique ${var.wazuh_mitre_technique_id}."
+    }
+  }
+}
+
+data "wazuh_mitre_techniques" "technique" {
+  technique_ids = [var.wazuh_mitre_technique_id]
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.techniques[0].tactic_ids, var.wazuh_mitre_tactic_id)
+      error_message = "Technique ${var.wazuh_mitre_technique_id} does not list tactic ${var.wazuh_mitre_tactic_id}."
+    }
+  }
+}
+
+data "wazuh_mitre_coverage" "tactic" {
+  tactic_ids = [var.wazuh_mitre_tactic_id]
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.covered_technique_ids, var.wazuh_mitre_technique_id)
+      error_message = "No enabled rule detects technique ${var.wazuh_mitre_technique_id}."
+    }
+  }
+}
@@ -0,0 +1,11 @@
+output "tactic_name" {
+  value = data.wazuh_mitre_tactics.tactic.names[0]
+}
+
+output "technique_name" {
+  value = data.wazuh_mitre_techniques.technique.names[0]
+}
+
+output "tactic_coverage_percent" {
+  value = data.wazuh_mitre_coverage.tactic.coverage_percent
+}
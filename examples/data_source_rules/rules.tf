@@ -0,0 +1,10 @@
+data "wazuh_rules" "group" {
+  group = var.wazuh_rules_group
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.ids, var.wazuh_rules_expected_id)
+      error_message = "Rule ${var.wazuh_rules_expected_id} is not in group ${var.wazuh_rules_group}."
+    }
+  }
+}
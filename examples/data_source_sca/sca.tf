@@ -0,0 +1,22 @@
+data "wazuh_sca_policies" "agent" {
+  agent_id = var.wazuh_agent_id
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.policy_ids) > 0
+      error_message = "No SCA policy results for agent ${var.wazuh_agent_id}."
+    }
+  }
+}
+
+data "wazuh_sca_checks" "first_policy" {
+  agent_id  = var.wazuh_agent_id
+  policy_id = data.wazuh_sca_policies.agent.policy_ids[0]
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.checks) > 0
+      error_message = "No checks returned for SCA policy ${data.wazuh_sca_policies.agent.policy_ids[0]}."
+    }
+  }
+}
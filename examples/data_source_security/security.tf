@@ -0,0 +1,79 @@
+data "wazuh_users" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_username)
+      error_message = "User ${var.wazuh_username} is not listed."
+    }
+  }
+}
+
+data "wazuh_user" "user" {
+  username = var.wazuh_username
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.role_ids) > 0
+      error_message = "User ${var.wazuh_username} has no roles."
+    }
+  }
+}
+
+data "wazuh_roles" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_role_name)
+      error_message = "Role ${var.wazuh_role_name} is not listed."
+    }
+  }
+}
+
+data "wazuh_role" "role" {
+  name = var.wazuh_role_name
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.policy_ids) > 0
+      error_message = "Role ${var.wazuh_role_name} has no policies."
+    }
+  }
+}
+
+data "wazuh_policies" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_policy_name)
+      error_message = "Policy ${var.wazuh_policy_name} is not listed."
+    }
+  }
+}
+
+data "wazuh_policy" "policy" {
+  name = var.wazuh_policy_name
+
+  lifecycle {
+    postcondition {
+      condition     = self.effect == "allow"
+      error_message = "Policy ${var.wazuh_policy_name} does not allow its actions."
+    }
+  }
+}
+
+data "wazuh_security_rules" "all" {
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, var.wazuh_security_rule_name)
+      error_message = "Security rule ${var.wazuh_security_rule_name} is not listed."
+    }
+  }
+}
+
+data "wazuh_security_rule" "rule" {
+  name = var.wazuh_security_rule_name
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.role_ids) > 0
+      error_message = "Security rule ${var.wazuh_security_rule_name} is not mapped to a role."
+    }
+  }
+}
@@ -0,0 +1,33 @@
+data "wazuh_syscollector_os" "agent" {
+  agent_id = var.wazuh_agent_id
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.os) == 1 && self.os[0].os_name != ""
+      error_message = "No operating system inventory for agent ${var.wazuh_agent_id}."
+    }
+  }
+}
+
+data "wazuh_syscollector_hardware" "agent" {
+  agent_id = var.wazuh_agent_id
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.hardware) == 1 && self.hardware[0].cpu_cores > 0
+      error_message = "No hardware inventory for agent ${var.wazuh_agent_id}."
+    }
+  }
+}
+
+data "wazuh_syscollector_packages" "agent" {
+  agent_id = var.wazuh_agent_id
+  limit    = 10
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.packages) > 0
+      error_message = "No package inventory for agent ${var.wazuh_agent_id}."
+    }
+  }
+}
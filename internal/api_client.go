@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// apiPageLimit is the maximum page size accepted by Wazuh list endpoints.
+const apiPageLimit = 500
+
+// apiError is returned by the request helpers when Wazuh answers with a non-2xx status.
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.StatusCode, e.Body)
+}
+
+// isNotFound reports whether err is an API error with status 404.
+func isNotFound(err error) bool {
+	var apiErr *apiError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// doRawRequest sends an authenticated request with an optional raw payload
+// (XML, CDB list text, ...) and returns the response body.
+func (c *APIClient) doRawRequest(ctx context.Context, method, path string, query url.Values, body []byte, contentType string) ([]byte, error) {
+	urlStr := fmt.Sprintf("%s/%s", c.Endpoint, path)
+	if enc := query.Encode(); enc != "" {
+		urlStr = urlStr + "?" + enc
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewBuffer(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, urlStr, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.AuthToken)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &apiError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	return respBody, nil
+}
+
+// doJSONRequest sends an authenticated request with an optional JSON payload,
+// decodes the response into out (if not nil) and checks the Wazuh "error" field.
+func (c *APIClient) doJSONRequest(ctx context.Context, method, path string, query url.Values, payload, out interface{}) error {
+	var body []byte
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = data
+	}
+
+	respBody, err := c.doRawRequest(ctx, method, path, query, body, "application/json")
+	if err != nil {
+		return err
+	}
+
+	var envelope struct {
+		Error int `json:"error"`
+	}
+	if err := json.Unmarshal(respBody, &envelope); err != nil {
+		return fmt.Errorf("failed to parse response of %s /%s: %w", method, path, err)
+	}
+	if envelope.Error == 1 {
+		return fmt.Errorf("Wazuh API returned error for %s /%s: %s", method, path, string(respBody))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to parse response of %s /%s: %w", method, path, err)
+		}
+	}
+
+	return nil
+}
+
+// listAffectedItems pages through a Wazuh list endpoint using offset/limit and
+// returns every element of data.affected_items. A "limit" already present in
+// query caps the total number of items returned.
+func (c *APIClient) listAffectedItems(ctx context.Context, path string, query url.Values) ([]json.RawMessage, error) {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+
+	maxItems := 0
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid limit %q", v)
+		}
+		maxItems = n
+	}
+
+	var items []json.RawMessage
+	offset := 0
+	for {
+		pageSize := apiPageLimit
+		if maxItems > 0 && maxItems-len(items) < pageSize {
+			pageSize = maxItems - len(items)
+		}
+		q.Set("offset", strconv.Itoa(offset))
+		q.Set("limit", strconv.Itoa(pageSize))
+
+		var result struct {
+			Data struct {
+				AffectedItems []json.RawMessage `json:"affected_items"`
+				TotalAffected int               `json:"total_affected_items"`
+			} `json:"data"`
+		}
+		if err := c.doJSONRequest(ctx, http.MethodGet, path, q, nil, &result); err != nil {
+			return nil, err
+		}
+
+		items = append(items, result.Data.AffectedItems...)
+		offset += len(result.Data.AffectedItems)
+
+		if len(result.Data.AffectedItems) == 0 || offset >= result.Data.TotalAffected {
+			break
+		}
+		if maxItems > 0 && len(items) >= maxItems {
+			break
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// Command is a subcommand of the provider binary (e.g. "backup").
+type Command struct {
+	Description string
+	Run         func(args []string, stdout io.Writer) error
+}
+
+// Commands lists the subcommands that can be run from the provider binary
+// instead of serving the Terraform plugin protocol.
+var Commands = map[string]Command{
+	"backup": {
+		Description: "Snapshot manager/node/group configuration, custom ruleset files and RBAC objects",
+		Run:         runBackup,
+	},
+	"restore": {
+		Description: "Restore a snapshot created by the backup command (supports --dry-run)",
+		Run:         runRestore,
+	},
+	"lint": {
+		Description: "Check rule and decoder XML files offline (text or SARIF output)",
+		Run:         runLint,
+	},
+	"drift": {
+		Description: "Compare a Terraform state file with the live API and report unmanaged objects",
+		Run:         runDrift,
+	},
+}
+
+// CommandUsage writes the list of available subcommands.
+func CommandUsage(w io.Writer) {
+	names := make([]string, 0, len(Commands))
+	for name := range Commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "Available commands:")
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-10s %s\n", name, Commands[name].Description)
+	}
+}
+
+// clientFlags holds the Wazuh API connection flags shared by all subcommands.
+// Defaults are taken from the same environment variables as the provider.
+type clientFlags struct {
+	endpoint      string
+	user          string
+	password      string
+	skipSSLVerify bool
+}
+
+func (f *clientFlags) register(fs *flag.FlagSet) {
+	skipSSL, _ := strconv.ParseBool(os.Getenv("WAZUH_SKIP_SSL_VERIFY"))
+
+	fs.StringVar(&f.endpoint, "endpoint", os.Getenv("WAZUH_ENDPOINT"), "Wazuh API endpoint (env WAZUH_ENDPOINT)")
+	fs.StringVar(&f.user, "user", os.Getenv("WAZUH_USER"), "Wazuh API username (env WAZUH_USER)")
+	fs.StringVar(&f.password, "password", os.Getenv("WAZUH_PASSWORD"), "Wazuh API password (env WAZUH_PASSWORD)")
+	fs.BoolVar(&f.skipSSLVerify, "skip-ssl-verify", skipSSL, "Skip SSL certificate verification (env WAZUH_SKIP_SSL_VERIFY)")
+}
+
+func (f *clientFlags) client() (*APIClient, error) {
+	if f.endpoint == "" || f.user == "" || f.password == "" {
+		return nil, fmt.Errorf("endpoint, user and password must be set (flags or WAZUH_* environment variables)")
+	}
+	return newAPIClient(f.endpoint, f.user, f.password, f.skipSSLVerify)
+}
@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// runBackup implements `terraform-provider-wazuh backup --out <dir|file.tar.gz>`.
+//
+// It snapshots:
+//   - manager ossec.conf            (GET /manager/configuration?raw=true)
+//   - per-node ossec.conf           (GET /cluster/{node_id}/configuration?raw=true)
+//   - group agent.conf files        (GET /groups/{group_id}/files/agent.conf?raw=true)
+//   - custom rules/decoders/lists   (GET /{rules,decoders,lists}/files/{filename}?raw=true)
+//   - RBAC users/roles/policies/rules (GET /security/...)
+func runBackup(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("backup", flag.ContinueOnError)
+	var cf clientFlags
+	cf.register(fs)
+	out := fs.String("out", "", "Output directory, or a .tar.gz/.tgz file to write a tarball")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *out == "" {
+		return fmt.Errorf("--out is required")
+	}
+
+	client, err := cf.client()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	snap := newSnapshot(client.Endpoint, managerVersion(ctx, client))
+	warn := func(format string, a ...interface{}) {
+		fmt.Fprintf(os.Stderr, "warning: "+format+"\n", a...)
+	}
+
+	// Manager configuration
+	if err := addToSnapshot(ctx, client, snap, snapshotFile{
+		Path: "manager/ossec.conf",
+		Kind: snapshotKindManagerConfiguration,
+	}); err != nil {
+		return fmt.Errorf("failed to back up manager configuration: %w", err)
+	}
+
+	// Per-node configuration (only when the cluster is enabled)
+	nodes, err := client.listAffectedItems(ctx, "cluster/nodes", nil)
+	if err != nil {
+		warn("skipping node configuration, cluster nodes could not be listed: %v", err)
+	}
+	for _, raw := range nodes {
+		var node struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &node); err != nil || node.Name == "" {
+			continue
+		}
+		if err := checkSnapshotName(node.Name); err != nil {
+			return fmt.Errorf("cannot back up node: %w", err)
+		}
+		if err := addToSnapshot(ctx, client, snap, snapshotFile{
+			Path: "nodes/" + node.Name + "/ossec.conf",
+			Kind: snapshotKindNodeConfiguration,
+			Name: node.Name,
+		}); err != nil {
+			return fmt.Errorf("failed to back up configuration of node '%s': %w", node.Name, err)
+		}
+	}
+
+	// Group agent.conf files
+	groups, err := client.listAffectedItems(ctx, "groups", nil)
+	if err != nil {
+		return fmt.Errorf("failed to list groups: %w", err)
+	}
+	for _, raw := range groups {
+		var group struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &group); err != nil || group.Name == "" {
+			continue
+		}
+		if err := checkSnapshotName(group.Name); err != nil {
+			return fmt.Errorf("cannot back up group: %w", err)
+		}
+		err := addToSnapshot(ctx, client, snap, snapshotFile{
+			Path: "groups/" + group.Name + "/agent.conf",
+			Kind: snapshotKindGroupConfiguration,
+			Name: group.Name,
+		})
+		if isNotFound(err) {
+			warn("group '%s' has no agent.conf, skipping", group.Name)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to back up configuration of group '%s': %w", group.Name, err)
+		}
+	}
+
+	// Custom ruleset files
+	for _, rs := range []struct {
+		endpoint string
+		dir      string
+		kind     string
+		query    url.Values
+	}{
+		{"rules/files", "rules", snapshotKindRuleFile, url.Values{"relative_dirname": {"etc/rules"}}},
+		{"decoders/files", "decoders", snapshotKindDecoderFile, url.Values{"relative_dirname": {"etc/decoders"}}},
+		{"lists/files", "lists", snapshotKindCDBList, nil},
+	} {
+		files, err := client.listAffectedItems(ctx, rs.endpoint, rs.query)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", rs.endpoint, err)
+		}
+		for _, raw := range files {
+			var file struct {
+				Filename        string `json:"filename"`
+				RelativeDirname string `json:"relative_dirname"`
+			}
+			if err := json.Unmarshal(raw, &file); err != nil || file.Filename == "" {
+				continue
+			}
+			if err := checkSnapshotName(file.Filename); err != nil {
+				return fmt.Errorf("cannot back up %s: %w", rs.dir, err)
+			}
+			if file.RelativeDirname != "" {
+				for _, elem := range strings.Split(file.RelativeDirname, "/") {
+					if err := checkSnapshotName(elem); err != nil {
+						return fmt.Errorf("cannot back up %s '%s': directory: %w", rs.dir, file.Filename, err)
+					}
+				}
+			}
+			// Mirror the server layout (etc/rules/..., etc/lists/amazon/...) so
+			// files with the same name in different directories do not collide.
+			dir := rs.dir
+			if file.RelativeDirname != "" {
+				dir = file.RelativeDirname
+			}
+			if err := addToSnapshot(ctx, client, snap, snapshotFile{
+				Path:            dir + "/" + file.Filename,
+				Kind:            rs.kind,
+				Name:            file.Filename,
+				RelativeDirname: file.RelativeDirname,
+			}); err != nil {
+				return fmt.Errorf("failed to back up %s '%s': %w", rs.dir, file.Filename, err)
+			}
+		}
+	}
+
+	// RBAC objects
+	for _, kind := range []string{
+		snapshotKindSecurityUsers,
+		snapshotKindSecurityRoles,
+		snapshotKindSecurityPolicies,
+		snapshotKindSecurityRules,
+	} {
+		f := snapshotFile{
+			Path: "security/" + kind[len("security_"):] + ".json",
+			Kind: kind,
+		}
+		if err := addToSnapshot(ctx, client, snap, f); err != nil {
+			return fmt.Errorf("failed to back up %s: %w", snapshotSecurityEndpoints[kind], err)
+		}
+	}
+
+	if err := snap.write(*out); err != nil {
+		return fmt.Errorf("failed to write backup to %q: %w", *out, err)
+	}
+
+	fmt.Fprintf(stdout, "Backed up %d files from %s to %s\n", len(snap.Manifest.Files), client.Endpoint, *out)
+	return nil
+}
+
+// checkSnapshotName rejects names reported by the API that would escape their
+// directory once used as a path element of the snapshot.
+func checkSnapshotName(name string) error {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.Contains(name, "..") {
+		return fmt.Errorf("invalid name %q", name)
+	}
+	return nil
+}
+
+func addToSnapshot(ctx context.Context, c *APIClient, snap *snapshot, f snapshotFile) error {
+	data, err := fetchSnapshotFile(ctx, c, f)
+	if err != nil {
+		return err
+	}
+	snap.add(f, data)
+	return nil
+}
+
+// managerVersion returns the Wazuh version from GET /manager/info, or "" if unavailable.
+func managerVersion(ctx context.Context, c *APIClient) string {
+	var result struct {
+		Data struct {
+			AffectedItems []struct {
+				Version string `json:"version"`
+			} `json:"affected_items"`
+		} `json:"data"`
+	}
+	if err := c.doJSONRequest(ctx, http.MethodGet, "manager/info", nil, nil, &result); err != nil {
+		return ""
+	}
+	if len(result.Data.AffectedItems) == 0 {
+		return ""
+	}
+	return result.Data.AffectedItems[0].Version
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// runDrift implements `terraform-provider-wazuh drift --state terraform.tfstate`.
+// It compares wazuh_* resources in a state file with the live API and lists
+// custom server-side objects that are not managed by Terraform.
+func runDrift(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("drift", flag.ContinueOnError)
+	var cf clientFlags
+	cf.register(fs)
+	statePath := fs.String("state", "terraform.tfstate", "Terraform state file (e.g. from `terraform state pull`)")
+	format := fs.String("format", "markdown", "Report format: markdown or json")
+	outPath := fs.String("out", "", "Write the report to this file instead of stdout")
+	failOnDrift := fs.Bool("fail-on-drift", false, "Exit with a non-zero status when drift or unmanaged objects are found")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "markdown" && *format != "json" {
+		return fmt.Errorf("unsupported format %q (expected markdown or json)", *format)
+	}
+
+	resources, err := readTerraformState(*statePath)
+	if err != nil {
+		return fmt.Errorf("failed to read state %q: %w", *statePath, err)
+	}
+
+	client, err := cf.client()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	report := &driftReport{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Endpoint:    client.Endpoint,
+		StateFile:   *statePath,
+		Managed:     []driftItem{},
+	}
+
+	for _, r := range resources {
+		check, ok := driftCheckers[r.Type]
+		if !ok {
+			report.Skipped = append(report.Skipped, r.Address)
+			continue
+		}
+		status, detail, err := check(ctx, client, r)
+		if err != nil {
+			return fmt.Errorf("failed to check %s: %w", r.Address, err)
+		}
+		report.Managed = append(report.Managed, driftItem{
+			Address: r.Address,
+			Type:    r.Type,
+			ID:      r.str("id"),
+			Status:  status,
+			Detail:  detail,
+		})
+		report.Summary.Checked++
+		switch status {
+		case driftInSync:
+			report.Summary.InSync++
+		case driftChanged:
+			report.Summary.Changed++
+		case driftMissing:
+			report.Summary.Missing++
+		}
+	}
+	sort.SliceStable(report.Managed, func(i, j int) bool {
+		return report.Managed[i].Address < report.Managed[j].Address
+	})
+
+	report.Unmanaged, err = findUnmanaged(ctx, client, resources)
+	if err != nil {
+		return err
+	}
+	if report.Unmanaged == nil {
+		report.Unmanaged = []driftUnmanaged{}
+	}
+	report.Summary.Unmanaged = len(report.Unmanaged)
+
+	w := stdout
+	if *outPath != "" {
+		f, err := os.Create(*outPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	default:
+		writeDriftMarkdown(w, report)
+	}
+
+	if *failOnDrift && report.hasDrift() {
+		return fmt.Errorf("drift detected: %d changed, %d missing, %d unmanaged", report.Summary.Changed, report.Summary.Missing, report.Summary.Unmanaged)
+	}
+	return nil
+}
+
+func writeDriftMarkdown(w io.Writer, r *driftReport) {
+	fmt.Fprintf(w, "# Wazuh drift report\n\n")
+	fmt.Fprintf(w, "- **Endpoint:** %s\n- **State file:** `%s`\n- **Generated at:** %s\n\n", r.Endpoint, r.StateFile, r.GeneratedAt)
+
+	fmt.Fprintf(w, "| Checked | In sync | Changed | Missing | Unmanaged |\n|---|---|---|---|---|\n")
+	fmt.Fprintf(w, "| %d | %d | %d | %d | %d |\n\n", r.Summary.Checked, r.Summary.InSync, r.Summary.Changed, r.Summary.Missing, r.Summary.Unmanaged)
+
+	fmt.Fprintf(w, "## Managed objects with drift\n\n")
+	drifted := 0
+	for _, item := range r.Managed {
+		if item.Status == driftInSync {
+			continue
+		}
+		if drifted == 0 {
+			fmt.Fprintf(w, "| Address | Status | Detail |\n|---|---|---|\n")
+		}
+		drifted++
+		fmt.Fprintf(w, "| `%s` | %s | %s |\n", item.Address, item.Status, markdownCell(item.Detail))
+	}
+	if drifted == 0 {
+		fmt.Fprintf(w, "No drift detected.\n")
+	}
+
+	fmt.Fprintf(w, "\n## Unmanaged objects\n\n")
+	if len(r.Unmanaged) == 0 {
+		fmt.Fprintf(w, "No unmanaged objects found.\n")
+	} else {
+		fmt.Fprintf(w, "| Kind | Name | ID |\n|---|---|---|\n")
+		for _, u := range r.Unmanaged {
+			fmt.Fprintf(w, "| %s | `%s` | %s |\n", u.Kind, u.Name, u.ID)
+		}
+	}
+
+	if len(r.Skipped) > 0 {
+		fmt.Fprintf(w, "\n<details><summary>%d action resource(s) not checked</summary>\n\n", len(r.Skipped))
+		for _, addr := range r.Skipped {
+			fmt.Fprintf(w, "- `%s`\n", addr)
+		}
+		fmt.Fprintf(w, "\n</details>\n")
+	}
+}
+
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
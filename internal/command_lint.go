@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+// stringSliceFlag collects a repeatable string flag.
+type stringSliceFlag []string
+
+func (s *stringSliceFlag) String() string { return fmt.Sprint(*s) }
+
+func (s *stringSliceFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+// runLint implements `terraform-provider-wazuh lint [flags] <file|dir>...`.
+// It works fully offline and does not need API credentials.
+func runLint(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	format := fs.String("format", "text", "Output format: text or sarif")
+	minID := fs.Int("min-id", customRuleIDMin, "Lowest allowed custom rule ID")
+	maxID := fs.Int("max-id", customRuleIDMax, "Highest allowed custom rule ID")
+	var reference stringSliceFlag
+	fs.Var(&reference, "ruleset", "Stock ruleset file or directory used to resolve if_sid/if_group/decoder references (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("at least one rule or decoder XML file or directory is required")
+	}
+	if *format != "text" && *format != "sarif" {
+		return fmt.Errorf("unsupported format %q (expected text or sarif)", *format)
+	}
+
+	linter := newRulesetLinter()
+	linter.MinID = *minID
+	linter.MaxID = *maxID
+
+	if err := linter.loadReference(reference); err != nil {
+		return fmt.Errorf("failed to load reference ruleset: %w", err)
+	}
+	if err := linter.load(fs.Args()); err != nil {
+		return err
+	}
+	issues := linter.run()
+
+	switch *format {
+	case "sarif":
+		if err := writeSARIF(stdout, issues); err != nil {
+			return err
+		}
+	default:
+		for _, i := range issues {
+			fmt.Fprintf(stdout, "%s:%d: %s [%s] %s\n", i.File, i.Line, i.Severity, i.Check, i.Message)
+		}
+		if len(reference) == 0 {
+			fmt.Fprintln(stdout, "note: no --ruleset given; if_group/if_matched_group, decoded_as and decoder <parent> references were not checked")
+		}
+		fmt.Fprintf(stdout, "%d file(s) checked, %d error(s)\n", linter.checked, len(issues))
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("lint found %d error(s)", len(issues))
+	}
+	return nil
+}
+
+// writeSARIF writes the issues as a SARIF 2.1.0 log, e.g. for GitHub code scanning.
+func writeSARIF(w io.Writer, issues []lintIssue) error {
+	type sarifMessage struct {
+		Text string `json:"text"`
+	}
+	type sarifRule struct {
+		ID               string       `json:"id"`
+		ShortDescription sarifMessage `json:"shortDescription"`
+	}
+	type sarifLocation struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Region struct {
+				StartLine int `json:"startLine"`
+			} `json:"region"`
+		} `json:"physicalLocation"`
+	}
+	type sarifResult struct {
+		RuleID    string          `json:"ruleId"`
+		Level     string          `json:"level"`
+		Message   sarifMessage    `json:"message"`
+		Locations []sarifLocation `json:"locations"`
+	}
+
+	rules := make([]sarifRule, 0, len(lintChecks))
+	for _, c := range lintChecks {
+		rules = append(rules, sarifRule{ID: c.ID, ShortDescription: sarifMessage{Text: c.Description}})
+	}
+
+	results := make([]sarifResult, 0, len(issues))
+	for _, i := range issues {
+		var loc sarifLocation
+		loc.PhysicalLocation.ArtifactLocation.URI = filepath.ToSlash(i.File)
+		loc.PhysicalLocation.Region.StartLine = i.Line
+		if loc.PhysicalLocation.Region.StartLine < 1 {
+			loc.PhysicalLocation.Region.StartLine = 1
+		}
+		results = append(results, sarifResult{
+			RuleID:    i.Check,
+			Level:     i.Severity,
+			Message:   sarifMessage{Text: i.Message},
+			Locations: []sarifLocation{loc},
+		})
+	}
+
+	log := map[string]interface{}{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs": []interface{}{
+			map[string]interface{}{
+				"tool": map[string]interface{}{
+					"driver": map[string]interface{}{
+						"name":           "terraform-provider-wazuh lint",
+						"informationUri": "https://github.com/grulicht/terraform-provider-wazuh",
+						"rules":          rules,
+					},
+				},
+				"results": results,
+			},
+		},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
@@ -0,0 +1,389 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// rbacReservedIDs is the upper bound (exclusive) of the IDs Wazuh reserves for
+// default RBAC objects. Those are never recreated by restore.
+const rbacReservedIDs = 100
+
+// snapshotRestoreOrder restores ruleset files before the configuration that references them.
+var snapshotRestoreOrder = []string{
+	snapshotKindCDBList,
+	snapshotKindDecoderFile,
+	snapshotKindRuleFile,
+	snapshotKindGroupConfiguration,
+	snapshotKindNodeConfiguration,
+	snapshotKindManagerConfiguration,
+}
+
+type restoreAction struct {
+	op   string // create, update, unchanged, skip
+	file snapshotFile
+}
+
+// runRestore implements `terraform-provider-wazuh restore --from <dir|file.tar.gz> [--dry-run]`.
+func runRestore(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("restore", flag.ContinueOnError)
+	var cf clientFlags
+	cf.register(fs)
+	from := fs.String("from", "", "Backup directory or .tar.gz/.tgz file created by the backup command")
+	dryRun := fs.Bool("dry-run", false, "Only print what would be restored")
+	skipRBAC := fs.Bool("skip-rbac", false, "Do not restore roles, policies and security rules")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" {
+		return fmt.Errorf("--from is required")
+	}
+
+	snap, err := readSnapshot(*from)
+	if err != nil {
+		return fmt.Errorf("failed to read backup %q: %w", *from, err)
+	}
+	if err := snap.verify(); err != nil {
+		return err
+	}
+
+	client, err := cf.client()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	fmt.Fprintf(stdout, "Backup of %s taken at %s (Wazuh %s)\n\n", snap.Manifest.Endpoint, snap.Manifest.CreatedAt, snap.Manifest.WazuhVersion)
+
+	// ---- Plan file restores ----
+	actions, err := planFileRestore(ctx, client, snap)
+	if err != nil {
+		return err
+	}
+
+	// ---- Plan RBAC restores ----
+	var rbac *rbacRestorePlan
+	if !*skipRBAC {
+		rbac, err = planRBACRestore(ctx, client, snap)
+		if err != nil {
+			return err
+		}
+	}
+
+	counts := map[string]int{}
+	for _, a := range actions {
+		counts[a.op]++
+		fmt.Fprintf(stdout, "  %-9s %-21s %s\n", a.op, a.file.Kind, a.file.Path)
+	}
+	if rbac != nil {
+		for _, o := range rbac.create {
+			counts["create"]++
+			fmt.Fprintf(stdout, "  %-9s %-21s %s\n", "create", o.kind, o.name)
+		}
+		if rbac.users > 0 {
+			fmt.Fprintf(stdout, "  %-9s %-21s %d custom user(s): passwords are not part of the backup, recreate them manually\n", "skip", snapshotKindSecurityUsers, rbac.users)
+		}
+	}
+	fmt.Fprintf(stdout, "\nPlan: %d to create, %d to update, %d unchanged.\n", counts["create"], counts["update"], counts["unchanged"])
+
+	if *dryRun {
+		return nil
+	}
+
+	// ---- Apply ----
+	for _, a := range actions {
+		if a.op == "unchanged" {
+			continue
+		}
+		if err := restoreSnapshotFile(ctx, client, a, snap.Data[a.file.Path]); err != nil {
+			return fmt.Errorf("failed to restore %s '%s': %w", a.file.Kind, a.file.Path, err)
+		}
+		fmt.Fprintf(stdout, "Restored %s\n", a.file.Path)
+	}
+
+	if rbac != nil {
+		if err := rbac.apply(ctx, client, stdout); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(stdout, "\nRestore complete. Restart the manager (or the affected cluster nodes) to load the restored configuration.")
+	return nil
+}
+
+// planFileRestore compares every snapshot file with the server, in restore
+// order, and decides whether it has to be created, updated or left alone.
+func planFileRestore(ctx context.Context, c *APIClient, snap *snapshot) ([]restoreAction, error) {
+	var actions []restoreAction
+	for _, kind := range snapshotRestoreOrder {
+		for _, f := range snap.Manifest.Files {
+			if f.Kind != kind {
+				continue
+			}
+			current, err := fetchSnapshotFile(ctx, c, f)
+			switch {
+			case isNotFound(err):
+				actions = append(actions, restoreAction{op: "create", file: f})
+			case err != nil:
+				return nil, fmt.Errorf("failed to read current %s '%s': %w", f.Kind, f.Path, err)
+			case sameContent(current, snap.Data[f.Path]):
+				actions = append(actions, restoreAction{op: "unchanged", file: f})
+			default:
+				actions = append(actions, restoreAction{op: "update", file: f})
+			}
+		}
+	}
+	return actions, nil
+}
+
+// restoreSnapshotFile uploads one snapshot file to its API endpoint.
+func restoreSnapshotFile(ctx context.Context, c *APIClient, a restoreAction, data []byte) error {
+	f := a.file
+	name := url.PathEscape(f.Name)
+	overwrite := url.Values{}
+	overwrite.Set("overwrite", "true")
+
+	var err error
+	switch f.Kind {
+	case snapshotKindManagerConfiguration:
+		_, err = c.doRawRequest(ctx, http.MethodPut, "manager/configuration", nil, data, "application/octet-stream")
+	case snapshotKindNodeConfiguration:
+		_, err = c.doRawRequest(ctx, http.MethodPut, "cluster/"+name+"/configuration", nil, data, "application/octet-stream")
+	case snapshotKindGroupConfiguration:
+		if a.op == "create" {
+			exists, lookupErr := groupExists(ctx, c, f.Name)
+			if lookupErr != nil {
+				return lookupErr
+			}
+			if !exists {
+				if err := c.doJSONRequest(ctx, http.MethodPost, "groups", nil, map[string]string{"group_id": f.Name}, nil); err != nil {
+					return err
+				}
+			}
+		}
+		_, err = c.doRawRequest(ctx, http.MethodPut, "groups/"+name+"/configuration", nil, data, "application/xml")
+	case snapshotKindRuleFile, snapshotKindDecoderFile:
+		if f.RelativeDirname != "" {
+			overwrite.Set("relative_dirname", f.RelativeDirname)
+		}
+		base := "rules"
+		if f.Kind == snapshotKindDecoderFile {
+			base = "decoders"
+		}
+		_, err = c.doRawRequest(ctx, http.MethodPut, base+"/files/"+name, overwrite, data, "application/octet-stream")
+	case snapshotKindCDBList:
+		_, err = c.doRawRequest(ctx, http.MethodPut, "lists/files/"+name, overwrite, data, "application/octet-stream")
+	default:
+		err = fmt.Errorf("unsupported kind %q", f.Kind)
+	}
+	return err
+}
+
+func groupExists(ctx context.Context, c *APIClient, groupID string) (bool, error) {
+	q := url.Values{}
+	q.Set("groups_list", groupID)
+
+	var result struct {
+		Data struct {
+			TotalAffected int `json:"total_affected_items"`
+		} `json:"data"`
+	}
+	// A missing group is reported as a failed item, not as an HTTP error.
+	body, err := c.doRawRequest(ctx, http.MethodGet, "groups", q, nil, "")
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return false, err
+	}
+	return result.Data.TotalAffected > 0, nil
+}
+
+// ---- RBAC ----
+
+type rbacObject struct {
+	ID       int             `json:"id"`
+	Name     string          `json:"name"`
+	Policy   json.RawMessage `json:"policy,omitempty"`
+	Rule     json.RawMessage `json:"rule,omitempty"`
+	Policies []int           `json:"policies,omitempty"`
+	Rules    []int           `json:"rules,omitempty"`
+}
+
+type rbacCreate struct {
+	kind   string
+	name   string
+	object rbacObject
+}
+
+type rbacRestorePlan struct {
+	create []rbacCreate
+	users  int
+
+	// snapshot ID -> name, used to re-link roles after creation
+	policyNames map[int]string
+	ruleNames   map[int]string
+}
+
+// planRBACRestore finds custom policies, security rules and roles present in the
+// snapshot but missing (by name) on the server.
+func planRBACRestore(ctx context.Context, c *APIClient, snap *snapshot) (*rbacRestorePlan, error) {
+	plan := &rbacRestorePlan{
+		policyNames: map[int]string{},
+		ruleNames:   map[int]string{},
+	}
+
+	for _, f := range snap.Manifest.Files {
+		if f.Kind == snapshotKindSecurityUsers {
+			var users []struct {
+				ID int `json:"id"`
+			}
+			if err := json.Unmarshal(snap.Data[f.Path], &users); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", f.Path, err)
+			}
+			for _, u := range users {
+				if u.ID >= rbacReservedIDs {
+					plan.users++
+				}
+			}
+		}
+	}
+
+	// Policies and rules first: roles link to them.
+	for _, kind := range []string{snapshotKindSecurityPolicies, snapshotKindSecurityRules, snapshotKindSecurityRoles} {
+		var objects []rbacObject
+		for _, f := range snap.Manifest.Files {
+			if f.Kind != kind {
+				continue
+			}
+			if err := json.Unmarshal(snap.Data[f.Path], &objects); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", f.Path, err)
+			}
+		}
+		if len(objects) == 0 {
+			continue
+		}
+
+		live, err := rbacNamesToIDs(ctx, c, snapshotSecurityEndpoints[kind])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range objects {
+			switch kind {
+			case snapshotKindSecurityPolicies:
+				plan.policyNames[o.ID] = o.Name
+			case snapshotKindSecurityRules:
+				plan.ruleNames[o.ID] = o.Name
+			}
+			if o.ID < rbacReservedIDs {
+				continue
+			}
+			if _, ok := live[o.Name]; ok {
+				continue
+			}
+			plan.create = append(plan.create, rbacCreate{kind: kind, name: o.Name, object: o})
+		}
+	}
+
+	return plan, nil
+}
+
+func (p *rbacRestorePlan) apply(ctx context.Context, c *APIClient, stdout io.Writer) error {
+	for _, o := range p.create {
+		var payload map[string]interface{}
+		switch o.kind {
+		case snapshotKindSecurityPolicies:
+			payload = map[string]interface{}{"name": o.name, "policy": o.object.Policy}
+		case snapshotKindSecurityRules:
+			payload = map[string]interface{}{"name": o.name, "rule": o.object.Rule}
+		case snapshotKindSecurityRoles:
+			payload = map[string]interface{}{"name": o.name}
+		}
+		endpoint := snapshotSecurityEndpoints[o.kind]
+		if err := c.doJSONRequest(ctx, http.MethodPost, endpoint, nil, payload, nil); err != nil {
+			return fmt.Errorf("failed to create %s '%s': %w", endpoint, o.name, err)
+		}
+		fmt.Fprintf(stdout, "Created %s '%s'\n", o.kind, o.name)
+	}
+
+	// Re-link the roles we created to their policies and rules, mapping snapshot
+	// IDs to the IDs assigned by this server through the object names.
+	livePolicies, err := rbacNamesToIDs(ctx, c, "security/policies")
+	if err != nil {
+		return err
+	}
+	liveRules, err := rbacNamesToIDs(ctx, c, "security/rules")
+	if err != nil {
+		return err
+	}
+	liveRoles, err := rbacNamesToIDs(ctx, c, "security/roles")
+	if err != nil {
+		return err
+	}
+
+	for _, o := range p.create {
+		if o.kind != snapshotKindSecurityRoles {
+			continue
+		}
+		roleID, ok := liveRoles[o.name]
+		if !ok {
+			return fmt.Errorf("role '%s' not found after creation", o.name)
+		}
+
+		for _, link := range []struct {
+			path  string
+			param string
+			ids   []int
+			names map[int]string
+			live  map[string]int
+		}{
+			{"policies", "policy_ids", o.object.Policies, p.policyNames, livePolicies},
+			{"rules", "rule_ids", o.object.Rules, p.ruleNames, liveRules},
+		} {
+			var ids []string
+			for _, id := range link.ids {
+				if liveID, ok := link.live[link.names[id]]; ok {
+					ids = append(ids, strconv.Itoa(liveID))
+				}
+			}
+			if len(ids) == 0 {
+				continue
+			}
+			sort.Strings(ids)
+			q := url.Values{}
+			q.Set(link.param, strings.Join(ids, ","))
+			path := fmt.Sprintf("security/roles/%d/%s", roleID, link.path)
+			if err := c.doJSONRequest(ctx, http.MethodPost, path, q, nil, nil); err != nil {
+				return fmt.Errorf("failed to link %s to role '%s': %w", link.path, o.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func rbacNamesToIDs(ctx context.Context, c *APIClient, endpoint string) (map[string]int, error) {
+	items, err := c.listAffectedItems(ctx, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", endpoint, err)
+	}
+	ids := make(map[string]int, len(items))
+	for _, raw := range items {
+		var o rbacObject
+		if err := json.Unmarshal(raw, &o); err != nil {
+			return nil, fmt.Errorf("failed to parse %s item: %w", endpoint, err)
+		}
+		ids[o.Name] = o.ID
+	}
+	return ids, nil
+}
@@ -0,0 +1,228 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeWazuhAPI serves raw files and RBAC lists from memory and records the
+// role links it receives.
+type fakeWazuhAPI struct {
+	mu       sync.Mutex
+	files    map[string]string       // "GET path" -> raw body
+	failures map[string]int          // "GET path" -> HTTP status
+	security map[string][]rbacObject // "security/roles" -> items
+	links    map[string][]string     // "security/roles/100/policies" -> queries
+}
+
+func newFakeWazuhAPI(t *testing.T) (*fakeWazuhAPI, *APIClient) {
+	t.Helper()
+	api := &fakeWazuhAPI{
+		files:    map[string]string{},
+		failures: map[string]int{},
+		security: map[string][]rbacObject{},
+		links:    map[string][]string{},
+	}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	return api, &APIClient{Endpoint: srv.URL, HTTPClient: *srv.Client()}
+}
+
+func (a *fakeWazuhAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	p := strings.TrimPrefix(r.URL.Path, "/")
+	if status, ok := a.failures[r.Method+" "+p]; ok {
+		w.WriteHeader(status)
+		fmt.Fprint(w, `{"title":"Internal Server Error","error":1}`)
+		return
+	}
+
+	if items, ok := a.security[p]; ok {
+		switch r.Method {
+		case http.MethodGet:
+			writeAffectedItems(w, items)
+			return
+		case http.MethodPost:
+			var o rbacObject
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &o)
+			o.ID = 100 + len(items)
+			a.security[p] = append(items, o)
+			writeAffectedItems(w, []rbacObject{o})
+			return
+		}
+	}
+	if r.Method == http.MethodPost && strings.HasPrefix(p, "security/roles/") {
+		a.links[p] = append(a.links[p], r.URL.RawQuery)
+		writeAffectedItems(w, []rbacObject{})
+		return
+	}
+	if body, ok := a.files[r.Method+" "+p]; ok {
+		fmt.Fprint(w, body)
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+	fmt.Fprint(w, `{"title":"Not Found","error":1}`)
+}
+
+func writeAffectedItems(w http.ResponseWriter, items []rbacObject) {
+	data, _ := json.Marshal(items)
+	fmt.Fprintf(w, `{"data":{"affected_items":%s,"total_affected_items":%d},"error":0}`, data, len(items))
+}
+
+func TestPlanFileRestore(t *testing.T) {
+	api, client := newFakeWazuhAPI(t)
+	api.files["GET manager/configuration"] = "<ossec_config/>\n\n"
+	api.files["GET groups/linux/files/agent.conf"] = "<agent_config>old</agent_config>\n"
+
+	snap := testSnapshot()
+	actions, err := planFileRestore(context.Background(), client, snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ruleset files come before the configuration that references them.
+	want := []string{
+		"create cdb_list etc/lists/amazon/aws-sources",
+		"update group_configuration groups/linux/agent.conf",
+		"unchanged manager_configuration manager/ossec.conf",
+	}
+	got := make([]string, 0, len(actions))
+	for _, a := range actions {
+		got = append(got, a.op+" "+a.file.Kind+" "+a.file.Path)
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("plan:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestPlanFileRestoreFailsOnAPIError(t *testing.T) {
+	api, client := newFakeWazuhAPI(t)
+	api.failures["GET manager/configuration"] = http.StatusInternalServerError
+
+	_, err := planFileRestore(context.Background(), client, testSnapshot())
+	if err == nil || !strings.Contains(err.Error(), "failed to read current manager_configuration") {
+		t.Errorf("planFileRestore() = %v, want a read error", err)
+	}
+}
+
+func rbacSnapshot(t *testing.T) *snapshot {
+	t.Helper()
+	snap := newSnapshot("https://wazuh.example.com:55000", "v4.14.0")
+	add := func(kind, file string, v interface{}) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		snap.add(snapshotFile{Path: "security/" + file, Kind: kind}, data)
+	}
+	add(snapshotKindSecurityUsers, "users.json", []map[string]interface{}{
+		{"id": 1, "username": "wazuh"},
+		{"id": 100, "username": "soc"},
+	})
+	add(snapshotKindSecurityPolicies, "policies.json", []rbacObject{
+		{ID: 1, Name: "agents_all_resourceless", Policy: json.RawMessage(`{"actions":["agent:read"]}`)},
+		// Reserved ID missing from the server: a default object, never recreated.
+		{ID: 42, Name: "removed_default_policy", Policy: json.RawMessage(`{}`)},
+		{ID: 100, Name: "soc_policy", Policy: json.RawMessage(`{"actions":["agent:read"],"resources":["agent:id:*"],"effect":"allow"}`)},
+		{ID: 101, Name: "existing_policy", Policy: json.RawMessage(`{}`)},
+	})
+	add(snapshotKindSecurityRules, "rules.json", []rbacObject{
+		{ID: 1, Name: "wui_elastic_admin", Rule: json.RawMessage(`{}`)},
+		{ID: 100, Name: "soc_rule", Rule: json.RawMessage(`{"FIND":{"user_name":"soc"}}`)},
+	})
+	add(snapshotKindSecurityRoles, "roles.json", []rbacObject{
+		{ID: 1, Name: "administrator", Policies: []int{1}},
+		{ID: 100, Name: "soc", Policies: []int{100, 101}, Rules: []int{100}},
+	})
+	return snap
+}
+
+func TestPlanRBACRestore(t *testing.T) {
+	api, client := newFakeWazuhAPI(t)
+	api.security["security/policies"] = []rbacObject{{ID: 1, Name: "agents_all_resourceless"}, {ID: 105, Name: "existing_policy"}}
+	api.security["security/rules"] = []rbacObject{{ID: 1, Name: "wui_elastic_admin"}}
+	api.security["security/roles"] = []rbacObject{{ID: 1, Name: "administrator"}}
+
+	plan, err := planRBACRestore(context.Background(), client, rbacSnapshot(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range plan.create {
+		got = append(got, c.kind+" "+c.name)
+	}
+	want := []string{
+		"security_policies soc_policy",
+		"security_rules soc_rule",
+		"security_roles soc",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("create:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if plan.users != 1 {
+		t.Errorf("users = %d, want 1 (reserved users are not counted)", plan.users)
+	}
+}
+
+func TestRBACRestoreApply(t *testing.T) {
+	api, client := newFakeWazuhAPI(t)
+	api.security["security/policies"] = []rbacObject{{ID: 1, Name: "agents_all_resourceless"}, {ID: 105, Name: "existing_policy"}}
+	api.security["security/rules"] = []rbacObject{{ID: 1, Name: "wui_elastic_admin"}}
+	api.security["security/roles"] = []rbacObject{{ID: 1, Name: "administrator"}}
+
+	ctx := context.Background()
+	plan, err := planRBACRestore(ctx, client, rbacSnapshot(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := plan.apply(ctx, client, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"Created security_policies 'soc_policy'",
+		"Created security_rules 'soc_rule'",
+		"Created security_roles 'soc'",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+
+	// The created role is linked through the IDs this server assigned, not the
+	// snapshot IDs: soc_policy became 102, existing_policy is 105, soc_rule 101.
+	roleID := -1
+	for _, r := range api.security["security/roles"] {
+		if r.Name == "soc" {
+			roleID = r.ID
+		}
+	}
+	if roleID < rbacReservedIDs {
+		t.Fatalf("role soc was not created: %+v", api.security["security/roles"])
+	}
+	links := map[string]string{
+		fmt.Sprintf("security/roles/%d/policies", roleID): "policy_ids=102%2C105",
+		fmt.Sprintf("security/roles/%d/rules", roleID):    "rule_ids=101",
+	}
+	for path, want := range links {
+		if got := strings.Join(api.links[path], "&"); got != want {
+			t.Errorf("POST %s?%s, want ?%s", path, got, want)
+		}
+	}
+	if _, ok := api.links["security/roles/1/policies"]; ok {
+		t.Error("reserved role administrator must not be re-linked")
+	}
+}
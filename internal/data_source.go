@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceQueryID returns a stable ID for a data source read, derived from
+// the API query so that identical lookups get identical IDs.
+func dataSourceQueryID(name string, q url.Values) string {
+	return fmt.Sprintf("%s-%s", name, sha256Hex([]byte(q.Encode()))[:12])
+}
+
+// queryParam maps a data source argument to a Wazuh API query parameter.
+type queryParam struct {
+	Attr  string
+	Param string
+}
+
+// buildQuery copies the non-empty arguments listed in params into an API
+// query. TypeList/TypeSet arguments are sent as comma separated values.
+func buildQuery(d *schema.ResourceData, params []queryParam) url.Values {
+	q := url.Values{}
+	for _, p := range params {
+		switch v := d.Get(p.Attr).(type) {
+		case string:
+			if v != "" {
+				q.Set(p.Param, v)
+			}
+		case int:
+			if v != 0 {
+				q.Set(p.Param, fmt.Sprint(v))
+			}
+		case bool:
+			if v {
+				q.Set(p.Param, "true")
+			}
+		case []interface{}:
+			if values := toStringSlice(v); len(values) > 0 {
+				q.Set(p.Param, strings.Join(values, ","))
+			}
+		case *schema.Set:
+			if values := toStringSlice(v.List()); len(values) > 0 {
+				q.Set(p.Param, strings.Join(values, ","))
+			}
+		}
+	}
+	return q
+}
+
+func toStringSlice(items []interface{}) []string {
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			if v != "" {
+				out = append(out, v)
+			}
+		case int:
+			out = append(out, strconv.Itoa(v))
+		}
+	}
+	return out
+}
+
+// dataSourceLimitSchema is the optional "limit" argument of list data sources.
+// Results are paged past the API maximum of 500 items automatically.
+func dataSourceLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Description: "Maximum number of items to return. 0 (default) returns all matching items, paging past the API limit of 500.",
+	}
+}
+
+// nodeIDSchema is the optional "node_id" argument of data sources that read
+// from the manager or, in a cluster, from a specific node.
+func nodeIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Cluster node name. If set, the /cluster/{node_id}/... endpoint is used instead of /manager/...",
+	}
+}
+
+// nodeScopedPath returns manager/<suffix>, or cluster/{node_id}/<suffix> when nodeID is set.
+func nodeScopedPath(nodeID, suffix string) string {
+	if nodeID == "" {
+		return "manager/" + suffix
+	}
+	return fmt.Sprintf("cluster/%s/%s", url.PathEscape(nodeID), suffix)
+}
+
+// affectedItems returns data.affected_items of a non-paginated endpoint.
+func affectedItems(ctx context.Context, client *APIClient, path string, query url.Values) ([]json.RawMessage, error) {
+	var result struct {
+		Data struct {
+			AffectedItems []json.RawMessage `json:"affected_items"`
+		} `json:"data"`
+	}
+	if err := client.doJSONRequest(ctx, http.MethodGet, path, query, nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Data.AffectedItems, nil
+}
+
+// firstAffectedItem returns data.affected_items[0] of a non-paginated endpoint.
+func firstAffectedItem(ctx context.Context, client *APIClient, path string, query url.Values) (json.RawMessage, error) {
+	items, err := affectedItems(ctx, client, path, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("GET /%s returned no items", path)
+	}
+	return items[0], nil
+}
+
+// scalarString renders a JSON scalar as a string ("" for null and objects).
+func scalarString(v interface{}) string {
+	switch t := v.(type) {
+	case nil, map[string]interface{}, []interface{}:
+		return ""
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+// flattenDetails converts the "details" object of rules and decoders into a
+// map of strings. Nested values (e.g. {"pattern": ..., "negate": ...}) are
+// kept as compact JSON.
+func flattenDetails(details map[string]json.RawMessage) map[string]string {
+	out := make(map[string]string, len(details))
+	for k, raw := range details {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			out[k] = s
+			continue
+		}
+		out[k] = string(raw)
+	}
+	return out
+}
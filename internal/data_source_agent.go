@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAgent looks up a single Wazuh agent by ID or name via:
+//   - GET /agents?agents_list=<id> (Read by agent_id)
+//   - GET /agents?name=<name>      (Read by name)
+func dataSourceAgent() *schema.Resource {
+	attrs := agentDataSourceAttributes()
+	delete(attrs, "id")
+
+	attrs["agent_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"agent_id", "name"},
+		Description:  "ID of the agent to look up (e.g. 001).",
+	}
+	attrs["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"agent_id", "name"},
+		Description:  "Name of the agent to look up.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceAgentRead,
+		Schema:      attrs,
+	}
+}
+
+func dataSourceAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	var items []json.RawMessage
+	lookup := d.Get("agent_id").(string)
+	if lookup != "" {
+		// An unknown ID is reported as a failed item (error=1), not as an HTTP
+		// error, so the envelope is read directly instead of via listAffectedItems.
+		body, err := client.doRawRequest(ctx, http.MethodGet, "agents", url.Values{"agents_list": {lookup}}, nil, "")
+		if err != nil {
+			return diag.Errorf("failed to read Wazuh agent '%s': %v", lookup, err)
+		}
+		var result struct {
+			Data struct {
+				AffectedItems []json.RawMessage `json:"affected_items"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &result); err != nil {
+			return diag.Errorf("failed to parse Wazuh agent '%s': %v", lookup, err)
+		}
+		items = result.Data.AffectedItems
+	} else {
+		lookup = d.Get("name").(string)
+		var err error
+		items, err = client.listAffectedItems(ctx, "agents", url.Values{"name": {lookup}})
+		if err != nil {
+			return diag.Errorf("failed to read Wazuh agent '%s': %v", lookup, err)
+		}
+	}
+	if len(items) == 0 {
+		return diag.Errorf("Wazuh agent '%s' not found", lookup)
+	}
+	if len(items) > 1 {
+		return diag.Errorf("multiple Wazuh agents found for '%s'; look the agent up by agent_id instead", lookup)
+	}
+
+	var a apiAgent
+	if err := json.Unmarshal(items[0], &a); err != nil {
+		return diag.Errorf("failed to parse Wazuh agent '%s': %v", lookup, err)
+	}
+
+	d.SetId(a.ID)
+	for k, v := range flattenAgent(a) {
+		if k == "id" {
+			k = "agent_id"
+		}
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
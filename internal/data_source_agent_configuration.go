@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAgentConfiguration reads the configuration an agent has actually
+// loaded via:
+//   - GET /agents/{agent_id}/config/{component}/{configuration} (Read)
+func dataSourceAgentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAgentConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the agent (e.g. 001). The agent must be active.",
+			},
+			"component": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Component to query, e.g. syscheck, logcollector, agent, wmodules.",
+			},
+			"configuration": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Configuration section of the component, e.g. syscheck, localfile, client.",
+			},
+
+			// ---- Results ----
+			"config_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Loaded configuration section as JSON (use jsondecode() for nested settings).",
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Top-level scalar settings of the section, rendered as strings.",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Scalar settings of each block when the section is a list (e.g. localfile).",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// scalarValues keeps the scalar members of a JSON object.
+func scalarValues(obj map[string]interface{}) map[string]string {
+	out := make(map[string]string, len(obj))
+	for k, v := range obj {
+		switch v.(type) {
+		case nil, map[string]interface{}, []interface{}:
+			continue
+		}
+		out[k] = scalarString(v)
+	}
+	return out
+}
+
+func dataSourceAgentConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	agentID := d.Get("agent_id").(string)
+	component := d.Get("component").(string)
+	section := d.Get("configuration").(string)
+
+	path := fmt.Sprintf("agents/%s/config/%s/%s",
+		url.PathEscape(agentID), url.PathEscape(component), url.PathEscape(section))
+
+	// The section is returned under its own name, e.g. {"data": {"syscheck": {...}}}.
+	var result struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := client.doJSONRequest(ctx, http.MethodGet, path, nil, nil, &result); err != nil {
+		return diag.Errorf("failed to read configuration '%s/%s' of Wazuh agent '%s': %v", component, section, agentID, err)
+	}
+	raw, ok := result.Data[section]
+	if !ok {
+		return diag.Errorf("Wazuh agent '%s' has no '%s/%s' configuration loaded", agentID, component, section)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return diag.Errorf("failed to parse configuration '%s/%s' of Wazuh agent '%s': %v", component, section, agentID, err)
+	}
+	values := map[string]string{}
+	items := []interface{}{}
+	switch t := decoded.(type) {
+	case map[string]interface{}:
+		values = scalarValues(t)
+	case []interface{}:
+		for _, e := range t {
+			if obj, ok := e.(map[string]interface{}); ok {
+				items = append(items, scalarValues(obj))
+			}
+		}
+	}
+
+	d.SetId(path)
+	_ = d.Set("config_json", string(raw))
+	if err := d.Set("values", values); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("items", items); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
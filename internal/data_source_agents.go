@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceAgents lists Wazuh agents via:
+//   - GET /agents (Read, paged)
+func dataSourceAgents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAgentsRead,
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			"status": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Filter by agent status (active, pending, never_connected, disconnected).",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by group name.",
+			},
+			"os_platform": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by OS platform (os.platform), e.g. ubuntu, windows, darwin.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by agent version, e.g. \"Wazuh v4.12.0\".",
+			},
+			"node_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by cluster node name.",
+			},
+			"older_than": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only agents whose last keep alive (or registration date if never connected) is older than this, e.g. \"7d\", \"12h\".",
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Wazuh query language filter, e.g. \"os.platform=ubuntu;status=active\".",
+			},
+			"select": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only request these fields from the API (e.g. [\"name\", \"status\"]). Attributes of fields not selected are left empty.",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the matching agents.",
+			},
+			"agents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching agents.",
+				Elem: &schema.Resource{
+					Schema: agentDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// apiAgent is an element of data.affected_items of GET /agents.
+type apiAgent struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	IP                string   `json:"ip"`
+	RegisterIP        string   `json:"registerIP"`
+	Status            string   `json:"status"`
+	StatusCode        int      `json:"status_code"`
+	Version           string   `json:"version"`
+	Manager           string   `json:"manager"`
+	NodeName          string   `json:"node_name"`
+	Group             []string `json:"group"`
+	GroupConfigStatus string   `json:"group_config_status"`
+	ConfigSum         string   `json:"configSum"`
+	MergedSum         string   `json:"mergedSum"`
+	DateAdd           string   `json:"dateAdd"`
+	LastKeepAlive     string   `json:"lastKeepAlive"`
+	DisconnectionTime string   `json:"disconnection_time"`
+	OS                struct {
+		Arch     string `json:"arch"`
+		Codename string `json:"codename"`
+		Major    string `json:"major"`
+		Minor    string `json:"minor"`
+		Name     string `json:"name"`
+		Platform string `json:"platform"`
+		Uname    string `json:"uname"`
+		Version  string `json:"version"`
+	} `json:"os"`
+}
+
+// agentDataSourceAttributes returns the computed per-agent attributes.
+func agentDataSourceAttributes() map[string]*schema.Schema {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	return map[string]*schema.Schema{
+		"id":                  str("Agent ID."),
+		"name":                str("Agent name."),
+		"ip":                  str("Agent IP address."),
+		"register_ip":         str("IP used when registering the agent."),
+		"status":              str("Agent status."),
+		"status_code":         {Type: schema.TypeInt, Computed: true, Description: "Numeric status code of the agent connection."},
+		"version":             str("Agent version."),
+		"manager":             str("Manager hostname the agent reports to."),
+		"node_name":           str("Cluster node the agent is connected to."),
+		"groups":              {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Description: "Groups the agent belongs to."},
+		"group_config_status": str("Whether the agent has applied its group configuration (synced / not synced)."),
+		"config_sum":          str("Checksum of the agent's shared configuration (configSum)."),
+		"merged_sum":          str("Checksum of the merged group files received by the agent (mergedSum)."),
+		"os_arch":             str("OS architecture."),
+		"os_codename":         str("OS codename."),
+		"os_major":            str("OS major version."),
+		"os_minor":            str("OS minor version."),
+		"os_name":             str("OS name."),
+		"os_platform":         str("OS platform."),
+		"os_uname":            str("uname of the agent host."),
+		"os_version":          str("OS version."),
+		"date_add":            str("Registration date."),
+		"last_keep_alive":     str("Last keep alive received from the agent."),
+		"disconnection_time":  str("Time the agent was last disconnected."),
+	}
+}
+
+func flattenAgent(a apiAgent) map[string]interface{} {
+	groups := a.Group
+	if groups == nil {
+		groups = []string{}
+	}
+	return map[string]interface{}{
+		"id":                  a.ID,
+		"name":                a.Name,
+		"ip":                  a.IP,
+		"register_ip":         a.RegisterIP,
+		"status":              a.Status,
+		"status_code":         a.StatusCode,
+		"version":             a.Version,
+		"manager":             a.Manager,
+		"node_name":           a.NodeName,
+		"groups":              groups,
+		"group_config_status": a.GroupConfigStatus,
+		"config_sum":          a.ConfigSum,
+		"merged_sum":          a.MergedSum,
+		"os_arch":             a.OS.Arch,
+		"os_codename":         a.OS.Codename,
+		"os_major":            a.OS.Major,
+		"os_minor":            a.OS.Minor,
+		"os_name":             a.OS.Name,
+		"os_platform":         a.OS.Platform,
+		"os_uname":            a.OS.Uname,
+		"os_version":          a.OS.Version,
+		"date_add":            a.DateAdd,
+		"last_keep_alive":     a.LastKeepAlive,
+		"disconnection_time":  a.DisconnectionTime,
+	}
+}
+
+func dataSourceAgentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{"status", "status"},
+		{"group", "group"},
+		{"os_platform", "os.platform"},
+		{"version", "version"},
+		{"node_name", "node_name"},
+		{"older_than", "older_than"},
+		{"q", "q"},
+		{"select", "select"},
+		{"limit", "limit"},
+	})
+
+	items, err := client.listAffectedItems(ctx, "agents", q)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh agents: %v", err)
+	}
+
+	ids := make([]string, 0, len(items))
+	agents := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var a apiAgent
+		if err := json.Unmarshal(raw, &a); err != nil {
+			return diag.Errorf("failed to parse Wazuh agent: %v", err)
+		}
+		ids = append(ids, a.ID)
+		agents = append(agents, flattenAgent(a))
+	}
+
+	d.SetId(dataSourceQueryID("agents", q))
+	_ = d.Set("ids", ids)
+	if err := d.Set("agents", agents); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceCDBList reads a CDB list (stock or managed elsewhere) via:
+//   - GET /lists                           (Read, locate the list file)
+//   - GET /lists/files/{filename}?raw=true (Read, list content)
+func dataSourceCDBList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCDBListRead,
+
+		Schema: map[string]*schema.Schema{
+			"filename": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CDB list file name, e.g. \"audit-keys\".",
+			},
+			"relative_dirname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Expected directory of the list, e.g. \"etc/lists/amazon\". The read fails if the list is in another directory.",
+			},
+
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Path of the list relative to the Wazuh installation directory, as used in <list> rule options.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Raw list content.",
+			},
+			"entries": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List entries, keyed by the list key. Keys without a value map to \"\".",
+			},
+			"entry_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entries in the list.",
+			},
+		},
+	}
+}
+
+func dataSourceCDBListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	filename := d.Get("filename").(string)
+
+	relativeDirname := d.Get("relative_dirname").(string)
+
+	// GET /lists/files/{filename} has no directory parameter, so a list is only
+	// read when its file name is unique across all list directories.
+	items, err := client.listAffectedItems(ctx, "lists", url.Values{"filename": {filename}})
+	if err != nil {
+		return diag.Errorf("failed to look up Wazuh CDB list '%s': %v", filename, err)
+	}
+	if len(items) == 0 {
+		return diag.Errorf("Wazuh CDB list '%s' not found", filename)
+	}
+	type listFile struct {
+		Filename        string `json:"filename"`
+		RelativeDirname string `json:"relative_dirname"`
+	}
+	lists := make([]listFile, 0, len(items))
+	dirs := make([]string, 0, len(items))
+	for _, raw := range items {
+		var l listFile
+		if err := json.Unmarshal(raw, &l); err != nil {
+			return diag.Errorf("failed to parse Wazuh CDB list '%s': %v", filename, err)
+		}
+		lists = append(lists, l)
+		dirs = append(dirs, l.RelativeDirname)
+	}
+	if len(lists) > 1 {
+		return diag.Errorf("Wazuh CDB list '%s' exists in several directories (%s); the API cannot read a specific one", filename, strings.Join(dirs, ", "))
+	}
+	list := lists[0]
+	if relativeDirname != "" && strings.Trim(relativeDirname, "/") != list.RelativeDirname {
+		return diag.Errorf("Wazuh CDB list '%s' not found in '%s' (it is in '%s')", filename, relativeDirname, list.RelativeDirname)
+	}
+
+	body, err := client.doRawRequest(ctx, http.MethodGet, "lists/files/"+url.PathEscape(filename), url.Values{"raw": {"true"}}, nil, "")
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh CDB list '%s': %v", filename, err)
+	}
+	content := string(body)
+
+	entries, duplicates, err := parseCDBList(content)
+	if err != nil {
+		return diag.Errorf("failed to parse Wazuh CDB list '%s': %v", filename, err)
+	}
+	var diags diag.Diagnostics
+	if len(duplicates) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Wazuh CDB list '%s' has duplicate keys", filename),
+			Detail:   "The first value of each key is used, as in the CDB file the manager compiles.\n" + strings.Join(duplicates, "\n"),
+		})
+	}
+
+	path := fmt.Sprintf("%s/%s", list.RelativeDirname, list.Filename)
+	d.SetId(path)
+	_ = d.Set("relative_dirname", list.RelativeDirname)
+	_ = d.Set("path", path)
+	_ = d.Set("content", content)
+	_ = d.Set("entries", entries)
+	_ = d.Set("entry_count", len(entries))
+
+	return diags
+}
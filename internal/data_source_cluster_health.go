@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceClusterHealth reports the state of a Wazuh cluster via:
+//   - GET /cluster/status      (Read, enabled/running)
+//   - GET /cluster/healthcheck (Read, per-node sync state, only when running)
+func dataSourceClusterHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"nodes_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only report these node names.",
+			},
+
+			// ---- Computed fields ----
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster is enabled in the manager configuration.",
+			},
+			"running": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the cluster daemon is running.",
+			},
+			"connected_nodes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of nodes reported by the healthcheck.",
+			},
+			"active_agents": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of active agents connected to the reported nodes.",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Per-node health.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":                       {Type: schema.TypeString, Computed: true, Description: "Node name."},
+						"type":                       {Type: schema.TypeString, Computed: true, Description: "Node type (master or worker)."},
+						"version":                    {Type: schema.TypeString, Computed: true, Description: "Wazuh version of the node."},
+						"ip":                         {Type: schema.TypeString, Computed: true, Description: "Node address."},
+						"active_agents":              {Type: schema.TypeInt, Computed: true, Description: "Active agents connected to the node."},
+						"last_keep_alive":            {Type: schema.TypeString, Computed: true, Description: "Last keep alive of the worker (empty for the master)."},
+						"sync_integrity_free":        {Type: schema.TypeBool, Computed: true, Description: "Whether no integrity sync is in progress (true for the master)."},
+						"sync_agent_info_free":       {Type: schema.TypeBool, Computed: true, Description: "Whether no agent-info sync is in progress (true for the master)."},
+						"last_sync_integrity_end":    {Type: schema.TypeString, Computed: true, Description: "End of the last integrity sync, as seen by the master."},
+						"last_sync_agent_info_end":   {Type: schema.TypeString, Computed: true, Description: "End of the last agent-info sync, as seen by the master."},
+						"last_sync_agent_groups_end": {Type: schema.TypeString, Computed: true, Description: "End of the last agent-groups sync, as seen by the master."},
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiClusterHealthNode is an element of data.affected_items of GET /cluster/healthcheck.
+// The master node has no status block.
+type apiClusterHealthNode struct {
+	Info struct {
+		Name          string `json:"name"`
+		Type          string `json:"type"`
+		Version       string `json:"version"`
+		IP            string `json:"ip"`
+		NActiveAgents int    `json:"n_active_agents"`
+	} `json:"info"`
+	Status *struct {
+		LastKeepAlive     string `json:"last_keep_alive"`
+		SyncIntegrityFree bool   `json:"sync_integrity_free"`
+		SyncAgentInfoFree bool   `json:"sync_agent_info_free"`
+		LastSyncIntegrity struct {
+			DateEndMaster string `json:"date_end_master"`
+		} `json:"last_sync_integrity"`
+		LastSyncAgentInfo struct {
+			DateEndMaster string `json:"date_end_master"`
+		} `json:"last_sync_agentinfo"`
+		LastSyncAgentGroups struct {
+			DateEndMaster string `json:"date_end_master"`
+		} `json:"last_sync_agentgroups"`
+	} `json:"status"`
+}
+
+func dataSourceClusterHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	var status struct {
+		Data struct {
+			Enabled string `json:"enabled"`
+			Running string `json:"running"`
+		} `json:"data"`
+	}
+	if err := client.doJSONRequest(ctx, http.MethodGet, "cluster/status", nil, nil, &status); err != nil {
+		return diag.Errorf("failed to read Wazuh cluster status: %v", err)
+	}
+	enabled := status.Data.Enabled == "yes"
+	running := status.Data.Running == "yes"
+
+	q := buildQuery(d, []queryParam{{"nodes_list", "nodes_list"}})
+
+	nodes := []map[string]interface{}{}
+	activeAgents := 0
+	if running {
+		// The healthcheck endpoint is not paginated.
+		var health struct {
+			Data struct {
+				AffectedItems []apiClusterHealthNode `json:"affected_items"`
+			} `json:"data"`
+		}
+		if err := client.doJSONRequest(ctx, http.MethodGet, "cluster/healthcheck", q, nil, &health); err != nil {
+			return diag.Errorf("failed to read Wazuh cluster healthcheck: %v", err)
+		}
+		for _, n := range health.Data.AffectedItems {
+			node := map[string]interface{}{
+				"name":                       n.Info.Name,
+				"type":                       n.Info.Type,
+				"version":                    n.Info.Version,
+				"ip":                         n.Info.IP,
+				"active_agents":              n.Info.NActiveAgents,
+				"last_keep_alive":            "",
+				"sync_integrity_free":        true,
+				"sync_agent_info_free":       true,
+				"last_sync_integrity_end":    "",
+				"last_sync_agent_info_end":   "",
+				"last_sync_agent_groups_end": "",
+			}
+			if n.Status != nil {
+				node["last_keep_alive"] = n.Status.LastKeepAlive
+				node["sync_integrity_free"] = n.Status.SyncIntegrityFree
+				node["sync_agent_info_free"] = n.Status.SyncAgentInfoFree
+				node["last_sync_integrity_end"] = n.Status.LastSyncIntegrity.DateEndMaster
+				node["last_sync_agent_info_end"] = n.Status.LastSyncAgentInfo.DateEndMaster
+				node["last_sync_agent_groups_end"] = n.Status.LastSyncAgentGroups.DateEndMaster
+			}
+			activeAgents += n.Info.NActiveAgents
+			nodes = append(nodes, node)
+		}
+	}
+
+	d.SetId(dataSourceQueryID("cluster-health", q))
+	_ = d.Set("enabled", enabled)
+	_ = d.Set("running", running)
+	_ = d.Set("connected_nodes", len(nodes))
+	_ = d.Set("active_agents", activeAgents)
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
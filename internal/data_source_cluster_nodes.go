@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceClusterNodes lists the nodes of a Wazuh cluster via:
+//   - GET /cluster/nodes (Read, paged)
+func dataSourceClusterNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only nodes of this type (master or worker).",
+			},
+			"nodes_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only these node names.",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only nodes whose fields contain this string.",
+			},
+
+			// ---- Results ----
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the matching nodes (usable as nodes_list or node_id of the node resources).",
+			},
+			"master": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the master node, if it matches the filters.",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching nodes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":    {Type: schema.TypeString, Computed: true, Description: "Node name."},
+						"type":    {Type: schema.TypeString, Computed: true, Description: "Node type (master or worker)."},
+						"version": {Type: schema.TypeString, Computed: true, Description: "Wazuh version of the node."},
+						"ip":      {Type: schema.TypeString, Computed: true, Description: "Node address."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClusterNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{"type", "type"},
+		{"nodes_list", "nodes_list"},
+		{"search", "search"},
+	})
+
+	items, err := client.listAffectedItems(ctx, "cluster/nodes", q)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh cluster nodes: %v", err)
+	}
+
+	names := make([]string, 0, len(items))
+	nodes := make([]map[string]interface{}, 0, len(items))
+	master := ""
+	for _, raw := range items {
+		var n struct {
+			Name    string `json:"name"`
+			Type    string `json:"type"`
+			Version string `json:"version"`
+			IP      string `json:"ip"`
+		}
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return diag.Errorf("failed to parse Wazuh cluster node: %v", err)
+		}
+		if n.Type == "master" {
+			master = n.Name
+		}
+		names = append(names, n.Name)
+		nodes = append(nodes, map[string]interface{}{
+			"name":    n.Name,
+			"type":    n.Type,
+			"version": n.Version,
+			"ip":      n.IP,
+		})
+	}
+
+	d.SetId(dataSourceQueryID("cluster-nodes", q))
+	_ = d.Set("names", names)
+	_ = d.Set("master", master)
+	if err := d.Set("nodes", nodes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
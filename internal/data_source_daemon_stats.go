@@ -0,0 +1,368 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// daemonQueue is a queue entry of the daemon statistics.
+type daemonQueue struct {
+	Size  int     `json:"size"`
+	Usage float64 `json:"usage"`
+}
+
+// analysisdMetrics is the subset of wazuh-analysisd metrics exposed as
+// attributes. The same shape is reported per agent.
+type analysisdMetrics struct {
+	Bytes struct {
+		Received int `json:"received"`
+	} `json:"bytes"`
+	Events struct {
+		Processed         int `json:"processed"`
+		Received          int `json:"received"`
+		ReceivedBreakdown struct {
+			DecodedBreakdown interface{} `json:"decoded_breakdown"`
+			DroppedBreakdown interface{} `json:"dropped_breakdown"`
+		} `json:"received_breakdown"`
+		WrittenBreakdown struct {
+			Alerts   int `json:"alerts"`
+			Archives int `json:"archives"`
+		} `json:"written_breakdown"`
+	} `json:"events"`
+	Queues map[string]daemonQueue `json:"queues"`
+}
+
+// remotedMetrics is the subset of wazuh-remoted metrics exposed as
+// attributes. The same shape is reported per agent.
+type remotedMetrics struct {
+	Bytes struct {
+		Received int `json:"received"`
+		Sent     int `json:"sent"`
+	} `json:"bytes"`
+	TCPSessions     int `json:"tcp_sessions"`
+	KeysReloadCount int `json:"keys_reload_count"`
+	Messages        struct {
+		ReceivedBreakdown map[string]interface{} `json:"received_breakdown"`
+		SentBreakdown     map[string]interface{} `json:"sent_breakdown"`
+	} `json:"messages"`
+	Queues struct {
+		Received daemonQueue `json:"received"`
+	} `json:"queues"`
+}
+
+// counterSum adds up all numeric leaves of a (nested) breakdown object.
+func counterSum(v interface{}) int {
+	switch t := v.(type) {
+	case float64:
+		return int(t)
+	case map[string]interface{}:
+		sum := 0
+		for _, c := range t {
+			sum += counterSum(c)
+		}
+		return sum
+	}
+	return 0
+}
+
+// directCounters keeps the numeric members of a breakdown object, skipping
+// nested sub-breakdowns (e.g. control_breakdown) that are already included.
+func directCounters(m map[string]interface{}) map[string]int {
+	out := make(map[string]int, len(m))
+	for k, v := range m {
+		if f, ok := v.(float64); ok {
+			out[k] = int(f)
+		}
+	}
+	return out
+}
+
+// daemonStatsListSchema describes the raw per-daemon "stats" attribute.
+func daemonStatsListSchema() *schema.Schema {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Raw statistics per daemon, sorted by name.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":         str("Daemon name."),
+				"uptime":       str("Time the daemon was started."),
+				"timestamp":    str("Time the statistics were taken."),
+				"metrics_json": str("Daemon metrics as JSON (use jsondecode() for counters not exposed as attributes)."),
+			},
+		},
+	}
+}
+
+func daemonsFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Daemons to read (wazuh-analysisd, wazuh-remoted, wazuh-db). Defaults to all supported daemons.",
+	}
+}
+
+// dataSourceManagerDaemonStats reads the daemon statistics of the manager via:
+//   - GET /manager/daemons/stats            (Read)
+//   - GET /cluster/{node_id}/daemons/stats  (Read, when node_id is set)
+func dataSourceManagerDaemonStats() *schema.Resource {
+	num := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeInt, Computed: true, Description: desc}
+	}
+	counters := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeInt}, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceManagerDaemonStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+			"daemons": daemonsFilterSchema(),
+
+			// ---- wazuh-analysisd ----
+			"analysisd_events_received":  num("Events received by wazuh-analysisd."),
+			"analysisd_events_processed": num("Events processed by wazuh-analysisd."),
+			"analysisd_events_dropped":   num("Events dropped by wazuh-analysisd (all queues)."),
+			"analysisd_alerts_written":   num("Alerts written by wazuh-analysisd."),
+			"analysisd_archives_written": num("Events written to the archives by wazuh-analysisd."),
+			"analysisd_bytes_received":   num("Bytes received by wazuh-analysisd."),
+
+			// ---- wazuh-remoted ----
+			"remoted_bytes_received":    num("Bytes received by wazuh-remoted."),
+			"remoted_bytes_sent":        num("Bytes sent by wazuh-remoted."),
+			"remoted_tcp_sessions":      num("Open TCP sessions of wazuh-remoted."),
+			"remoted_keys_reload_count": num("Number of client.keys reloads."),
+			"remoted_messages_received": counters("Messages received by wazuh-remoted per type (event, control, ping, discarded, ...)."),
+			"remoted_messages_sent":     counters("Messages sent by wazuh-remoted per type (ack, ar, shared, ...)."),
+			"remoted_queue_size":        num("Size of the wazuh-remoted receive queue."),
+			"remoted_queue_usage":       {Type: schema.TypeFloat, Computed: true, Description: "Usage of the wazuh-remoted receive queue (0-1)."},
+
+			"queues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Queues of all daemons, sorted by daemon and queue name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daemon": {Type: schema.TypeString, Computed: true, Description: "Daemon owning the queue."},
+						"name":   {Type: schema.TypeString, Computed: true, Description: "Queue name (e.g. syscheck, alerts, received)."},
+						"size":   num("Queue capacity."),
+						"usage":  {Type: schema.TypeFloat, Computed: true, Description: "Queue usage (0-1)."},
+					},
+				},
+			},
+			"stats": daemonStatsListSchema(),
+		},
+	}
+}
+
+// daemonStatsItem is one affected item of the daemon statistics endpoints.
+type daemonStatsItem struct {
+	Name      string          `json:"name"`
+	Uptime    string          `json:"uptime"`
+	Timestamp string          `json:"timestamp"`
+	Metrics   json.RawMessage `json:"metrics"`
+	Agents    []struct {
+		Uptime  string          `json:"uptime"`
+		Metrics json.RawMessage `json:"metrics"`
+	} `json:"agents"`
+}
+
+func readDaemonStats(ctx context.Context, client *APIClient, path string, q url.Values) ([]daemonStatsItem, error) {
+	items, err := affectedItems(ctx, client, path, q)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]daemonStatsItem, 0, len(items))
+	for _, raw := range items {
+		var item daemonStatsItem
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("failed to parse daemon statistics: %v", err)
+		}
+		out = append(out, item)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func dataSourceManagerDaemonStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "daemons/stats")
+	q := buildQuery(d, []queryParam{{"daemons", "daemons_list"}})
+
+	items, err := readDaemonStats(ctx, client, path, q)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh daemon statistics: %v", err)
+	}
+
+	var analysisd analysisdMetrics
+	var remoted remotedMetrics
+	queues := []map[string]interface{}{}
+	stats := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		switch item.Name {
+		case "wazuh-analysisd":
+			if err := json.Unmarshal(item.Metrics, &analysisd); err != nil {
+				return diag.Errorf("failed to parse wazuh-analysisd statistics: %v", err)
+			}
+			names := make([]string, 0, len(analysisd.Queues))
+			for name := range analysisd.Queues {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				queues = append(queues, map[string]interface{}{
+					"daemon": item.Name,
+					"name":   name,
+					"size":   analysisd.Queues[name].Size,
+					"usage":  analysisd.Queues[name].Usage,
+				})
+			}
+		case "wazuh-remoted":
+			if err := json.Unmarshal(item.Metrics, &remoted); err != nil {
+				return diag.Errorf("failed to parse wazuh-remoted statistics: %v", err)
+			}
+			queues = append(queues, map[string]interface{}{
+				"daemon": item.Name,
+				"name":   "received",
+				"size":   remoted.Queues.Received.Size,
+				"usage":  remoted.Queues.Received.Usage,
+			})
+		}
+		stats = append(stats, map[string]interface{}{
+			"name":         item.Name,
+			"uptime":       item.Uptime,
+			"timestamp":    item.Timestamp,
+			"metrics_json": string(item.Metrics),
+		})
+	}
+
+	d.SetId(dataSourceQueryID(path, q))
+	_ = d.Set("analysisd_events_received", analysisd.Events.Received)
+	_ = d.Set("analysisd_events_processed", analysisd.Events.Processed)
+	_ = d.Set("analysisd_events_dropped", counterSum(analysisd.Events.ReceivedBreakdown.DroppedBreakdown))
+	_ = d.Set("analysisd_alerts_written", analysisd.Events.WrittenBreakdown.Alerts)
+	_ = d.Set("analysisd_archives_written", analysisd.Events.WrittenBreakdown.Archives)
+	_ = d.Set("analysisd_bytes_received", analysisd.Bytes.Received)
+	_ = d.Set("remoted_bytes_received", remoted.Bytes.Received)
+	_ = d.Set("remoted_bytes_sent", remoted.Bytes.Sent)
+	_ = d.Set("remoted_tcp_sessions", remoted.TCPSessions)
+	_ = d.Set("remoted_keys_reload_count", remoted.KeysReloadCount)
+	_ = d.Set("remoted_queue_size", remoted.Queues.Received.Size)
+	_ = d.Set("remoted_queue_usage", remoted.Queues.Received.Usage)
+	if err := d.Set("remoted_messages_received", directCounters(remoted.Messages.ReceivedBreakdown)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remoted_messages_sent", directCounters(remoted.Messages.SentBreakdown)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("queues", queues); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("stats", stats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// dataSourceAgentDaemonStats reads the statistics the manager daemons keep
+// for one agent via:
+//   - GET /agents/{agent_id}/daemons/stats (Read)
+func dataSourceAgentDaemonStats() *schema.Resource {
+	num := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeInt, Computed: true, Description: desc}
+	}
+	counters := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeInt}, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceAgentDaemonStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the agent (e.g. 001).",
+			},
+			"daemons": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Daemons to read (wazuh-analysisd, wazuh-remoted). Defaults to both.",
+			},
+
+			"analysisd_events_processed": num("Events of the agent processed by wazuh-analysisd."),
+			"analysisd_events_decoded":   num("Events of the agent decoded by wazuh-analysisd."),
+			"analysisd_alerts_written":   num("Alerts written for the agent."),
+			"analysisd_archives_written": num("Events of the agent written to the archives."),
+			"remoted_messages_received":  counters("Messages received from the agent per type (event, control, ...)."),
+			"remoted_messages_sent":      counters("Messages sent to the agent per type (ack, ar, shared, ...)."),
+			"stats":                      daemonStatsListSchema(),
+		},
+	}
+}
+
+func dataSourceAgentDaemonStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	agentID := d.Get("agent_id").(string)
+	path := fmt.Sprintf("agents/%s/daemons/stats", url.PathEscape(agentID))
+	q := buildQuery(d, []queryParam{{"daemons", "daemons_list"}})
+
+	items, err := readDaemonStats(ctx, client, path, q)
+	if err != nil {
+		return diag.Errorf("failed to read daemon statistics of Wazuh agent '%s': %v", agentID, err)
+	}
+
+	var analysisd analysisdMetrics
+	var remoted remotedMetrics
+	stats := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if len(item.Agents) == 0 {
+			continue
+		}
+		metrics := item.Agents[0].Metrics
+		switch item.Name {
+		case "wazuh-analysisd":
+			if err := json.Unmarshal(metrics, &analysisd); err != nil {
+				return diag.Errorf("failed to parse wazuh-analysisd statistics of Wazuh agent '%s': %v", agentID, err)
+			}
+		case "wazuh-remoted":
+			if err := json.Unmarshal(metrics, &remoted); err != nil {
+				return diag.Errorf("failed to parse wazuh-remoted statistics of Wazuh agent '%s': %v", agentID, err)
+			}
+		}
+		stats = append(stats, map[string]interface{}{
+			"name":         item.Name,
+			"uptime":       item.Agents[0].Uptime,
+			"timestamp":    item.Timestamp,
+			"metrics_json": string(metrics),
+		})
+	}
+
+	d.SetId(dataSourceQueryID(path, q))
+	_ = d.Set("analysisd_events_processed", analysisd.Events.Processed)
+	_ = d.Set("analysisd_events_decoded", counterSum(analysisd.Events.ReceivedBreakdown.DecodedBreakdown))
+	_ = d.Set("analysisd_alerts_written", analysisd.Events.WrittenBreakdown.Alerts)
+	_ = d.Set("analysisd_archives_written", analysisd.Events.WrittenBreakdown.Archives)
+	if err := d.Set("remoted_messages_received", directCounters(remoted.Messages.ReceivedBreakdown)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("remoted_messages_sent", directCounters(remoted.Messages.SentBreakdown)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("stats", stats); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceDecoders lists the loaded decoders via:
+//   - GET /decoders         (Read, paged)
+//   - GET /decoders/parents (Read, paged, when parents_only is set)
+func dataSourceDecoders() *schema.Resource {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceDecodersRead,
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only decoders with this name (a parent and all children sharing its name).",
+			},
+			"filename": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parents_only"},
+				Description:   "Filter by decoder file name, e.g. \"0310-ssh_decoders.xml\".",
+			},
+			"relative_dirname": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parents_only"},
+				Description:   "Filter by directory, e.g. \"ruleset/decoders\" (stock) or \"etc/decoders\" (custom).",
+			},
+			"status": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parents_only"},
+				Description:   "Filter by status: enabled, disabled or all.",
+			},
+			"parents_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return parent decoders (GET /decoders/parents).",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only decoders whose fields contain this string.",
+			},
+			"q": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parents_only"},
+				Description:   "Wazuh query language filter, e.g. \"details.parent=sshd\".",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Distinct names of the matching decoders, in API order.",
+			},
+			"decoders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching decoders.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":             str("Decoder name."),
+						"position":         {Type: schema.TypeInt, Computed: true, Description: "Position of the decoder among the decoders sharing its name."},
+						"status":           str("enabled or disabled."),
+						"filename":         str("File the decoder is defined in."),
+						"relative_dirname": str("Directory of the decoder file."),
+						"parent":           str("Name of the parent decoder (empty for parent decoders)."),
+						"prematch":         str("prematch pattern."),
+						"regex":            str("regex pattern."),
+						"order":            str("Fields extracted by regex, e.g. \"user, srcip, srcport\"."),
+						"details": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "All decoder options. Structured values are JSON encoded.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiDecoder is an element of data.affected_items of GET /decoders.
+type apiDecoder struct {
+	Name            string                     `json:"name"`
+	Position        int                        `json:"position"`
+	Status          string                     `json:"status"`
+	Filename        string                     `json:"filename"`
+	RelativeDirname string                     `json:"relative_dirname"`
+	Details         map[string]json.RawMessage `json:"details"`
+}
+
+// decoderPattern returns the pattern of a prematch/regex detail, which is
+// either a plain string or an object like {"pattern": "...", "offset": "..."}.
+func decoderPattern(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var obj struct {
+		Pattern string `json:"pattern"`
+	}
+	if err := json.Unmarshal(raw, &obj); err == nil && obj.Pattern != "" {
+		return obj.Pattern
+	}
+	return string(raw)
+}
+
+func dataSourceDecodersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	path := "decoders"
+	params := []queryParam{
+		{"name", "decoder_names"},
+		{"filename", "filename"},
+		{"relative_dirname", "relative_dirname"},
+		{"status", "status"},
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	}
+	if d.Get("parents_only").(bool) {
+		path = "decoders/parents"
+		params = []queryParam{
+			{"search", "search"},
+			{"limit", "limit"},
+		}
+	}
+	q := buildQuery(d, params)
+
+	// GET /decoders/parents does not filter by name, so do it here. The API
+	// limit would apply before that filter, so the limit is applied here too.
+	nameFilter := ""
+	limit := 0
+	if path == "decoders/parents" {
+		nameFilter = d.Get("name").(string)
+	}
+	if nameFilter != "" {
+		limit = d.Get("limit").(int)
+		q.Del("limit")
+	}
+
+	items, err := client.listAffectedItems(ctx, path, q)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh decoders: %v", err)
+	}
+
+	names := []string{}
+	seen := map[string]bool{}
+	decoders := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var dec apiDecoder
+		if err := json.Unmarshal(raw, &dec); err != nil {
+			return diag.Errorf("failed to parse Wazuh decoder: %v", err)
+		}
+		if nameFilter != "" && dec.Name != nameFilter {
+			continue
+		}
+		if limit > 0 && len(decoders) >= limit {
+			break
+		}
+		if !seen[dec.Name] {
+			seen[dec.Name] = true
+			names = append(names, dec.Name)
+		}
+		details := flattenDetails(dec.Details)
+		decoders = append(decoders, map[string]interface{}{
+			"name":             dec.Name,
+			"position":         dec.Position,
+			"status":           dec.Status,
+			"filename":         dec.Filename,
+			"relative_dirname": dec.RelativeDirname,
+			"parent":           details["parent"],
+			"prematch":         decoderPattern(dec.Details["prematch"]),
+			"regex":            decoderPattern(dec.Details["regex"]),
+			"order":            details["order"],
+			"details":          details,
+		})
+	}
+
+	idQuery := url.Values{"name": {nameFilter}}
+	for k, v := range q {
+		idQuery[k] = v
+	}
+	if limit > 0 {
+		idQuery.Set("limit", strconv.Itoa(limit))
+	}
+	d.SetId(dataSourceQueryID(path, idQuery))
+	_ = d.Set("names", names)
+	if err := d.Set("decoders", decoders); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
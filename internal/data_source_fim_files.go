@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceFIMFiles lists the file integrity monitoring entries of an agent via:
+//   - GET /syscheck/{agent_id}           (Read, paged)
+//   - GET /syscheck/{agent_id}/last_scan (Read)
+func dataSourceFIMFiles() *schema.Resource {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	filter := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceFIMFilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent ID, e.g. \"001\".",
+			},
+
+			// ---- Filters ----
+			"file":   filter("Filter by full path of the file or registry key."),
+			"hash":   filter("Filter by MD5, SHA1 or SHA256 hash."),
+			"type":   filter("Filter by type: file, registry_key or registry_value."),
+			"search": filter("Only entries whose fields contain this string."),
+			"q":      filter("Wazuh query language filter."),
+			"summary": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return the path, type, modification and scan date of each entry.",
+			},
+			"distinct": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Drop duplicated entries.",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"last_scan_start": str("Start time of the last FIM scan (empty if the agent never completed one)."),
+			"last_scan_end":   str("End time of the last FIM scan (empty while a scan is running)."),
+			"files": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching FIM entries.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file":    str("Path of the file or registry key."),
+						"type":    str("file, registry_key or registry_value."),
+						"size":    {Type: schema.TypeInt, Computed: true, Description: "Size in bytes."},
+						"perm":    str("Permissions."),
+						"uid":     str("Owner user ID."),
+						"gid":     str("Owner group ID."),
+						"uname":   str("Owner user name."),
+						"gname":   str("Owner group name."),
+						"inode":   {Type: schema.TypeInt, Computed: true, Description: "Inode number."},
+						"md5":     str("MD5 checksum."),
+						"sha1":    str("SHA1 checksum."),
+						"sha256":  str("SHA256 checksum."),
+						"mtime":   str("Last modification time."),
+						"date":    str("Time the entry was last scanned."),
+						"changes": {Type: schema.TypeInt, Computed: true, Description: "Number of changes detected."},
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiFIMFile is an element of data.affected_items of GET /syscheck/{agent_id}.
+type apiFIMFile struct {
+	File    string `json:"file"`
+	Type    string `json:"type"`
+	Size    int    `json:"size"`
+	Perm    string `json:"perm"`
+	UID     string `json:"uid"`
+	GID     string `json:"gid"`
+	Uname   string `json:"uname"`
+	Gname   string `json:"gname"`
+	Inode   int    `json:"inode"`
+	MD5     string `json:"md5"`
+	SHA1    string `json:"sha1"`
+	SHA256  string `json:"sha256"`
+	Mtime   string `json:"mtime"`
+	Date    string `json:"date"`
+	Changes int    `json:"changes"`
+}
+
+func dataSourceFIMFilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	agentID := d.Get("agent_id").(string)
+
+	q := buildQuery(d, []queryParam{
+		{"file", "file"},
+		{"hash", "hash"},
+		{"type", "type"},
+		{"search", "search"},
+		{"q", "q"},
+		{"summary", "summary"},
+		{"distinct", "distinct"},
+		{"limit", "limit"},
+	})
+
+	path := fmt.Sprintf("syscheck/%s", url.PathEscape(agentID))
+	items, err := client.listAffectedItems(ctx, path, q)
+	if err != nil {
+		return diag.Errorf("failed to list FIM entries of Wazuh agent '%s': %v", agentID, err)
+	}
+
+	files := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var f apiFIMFile
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return diag.Errorf("failed to parse FIM entry of Wazuh agent '%s': %v", agentID, err)
+		}
+		files = append(files, map[string]interface{}{
+			"file":    f.File,
+			"type":    f.Type,
+			"size":    f.Size,
+			"perm":    f.Perm,
+			"uid":     f.UID,
+			"gid":     f.GID,
+			"uname":   f.Uname,
+			"gname":   f.Gname,
+			"inode":   f.Inode,
+			"md5":     f.MD5,
+			"sha1":    f.SHA1,
+			"sha256":  f.SHA256,
+			"mtime":   f.Mtime,
+			"date":    f.Date,
+			"changes": f.Changes,
+		})
+	}
+
+	raw, err := firstAffectedItem(ctx, client, path+"/last_scan", nil)
+	if err != nil {
+		return diag.Errorf("failed to read last FIM scan of Wazuh agent '%s': %v", agentID, err)
+	}
+	// start/end are null until the agent has completed a scan.
+	var lastScan struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+	}
+	if err := json.Unmarshal(raw, &lastScan); err != nil {
+		return diag.Errorf("failed to parse last FIM scan of Wazuh agent '%s': %v", agentID, err)
+	}
+
+	q.Set("agent_id", agentID)
+	d.SetId(dataSourceQueryID("fim-files", q))
+	_ = d.Set("last_scan_start", lastScan.Start)
+	_ = d.Set("last_scan_end", lastScan.End)
+	if err := d.Set("files", files); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
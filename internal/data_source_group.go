@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceGroup reads a Wazuh agent group via:
+//   - GET /groups?groups_list=<name>            (Read, counts and checksums)
+//   - GET /groups/{group_id}/agents             (Read, member agent IDs)
+//   - GET /groups/{group_id}/files              (Read, shared files)
+//   - GET /groups/{group_id}/files/{file}?raw=true (Read, agent.conf / merged.mg content)
+func dataSourceGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Group name.",
+			},
+			"include_agent_conf": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, also return the raw content of agent.conf.",
+			},
+			"include_merged_mg": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, also return the raw content of merged.mg (what agents of the group actually receive).",
+			},
+
+			// ---- Computed fields ----
+			"agent_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of agents in the group.",
+			},
+			"config_sum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of agent.conf (configSum).",
+			},
+			"merged_sum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of merged.mg (mergedSum).",
+			},
+			"agent_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the agents in the group.",
+			},
+			"files": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Files shared with the group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filename": {Type: schema.TypeString, Computed: true, Description: "File name."},
+						"hash":     {Type: schema.TypeString, Computed: true, Description: "MD5 hash of the file."},
+					},
+				},
+			},
+			"agent_conf": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Raw content of agent.conf (only with include_agent_conf).",
+			},
+			"merged_mg": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Raw content of merged.mg (only with include_merged_mg).",
+			},
+		},
+	}
+}
+
+// apiGroup is an element of data.affected_items of GET /groups.
+type apiGroup struct {
+	Name      string `json:"name"`
+	Count     int    `json:"count"`
+	ConfigSum string `json:"configSum"`
+	MergedSum string `json:"mergedSum"`
+}
+
+func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	name := d.Get("name").(string)
+
+	// A missing group is reported as a failed item (error=1), not as an HTTP
+	// error, so the envelope is read directly instead of via listAffectedItems.
+	body, err := client.doRawRequest(ctx, http.MethodGet, "groups", url.Values{"groups_list": {name}}, nil, "")
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh group '%s': %v", name, err)
+	}
+	var result struct {
+		Data struct {
+			AffectedItems []apiGroup `json:"affected_items"`
+			TotalAffected int        `json:"total_affected_items"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return diag.Errorf("failed to parse Wazuh group '%s': %v", name, err)
+	}
+	if result.Data.TotalAffected == 0 || len(result.Data.AffectedItems) == 0 {
+		return diag.Errorf("Wazuh group '%s' not found", name)
+	}
+	g := result.Data.AffectedItems[0]
+
+	agentIDs, err := groupAgentIDs(ctx, client, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fileItems, err := client.listAffectedItems(ctx, fmt.Sprintf("groups/%s/files", url.PathEscape(name)), nil)
+	if err != nil {
+		return diag.Errorf("failed to list files of Wazuh group '%s': %v", name, err)
+	}
+	files := make([]map[string]interface{}, 0, len(fileItems))
+	for _, raw := range fileItems {
+		var f struct {
+			Filename string `json:"filename"`
+			Hash     string `json:"hash"`
+		}
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return diag.Errorf("failed to parse files of Wazuh group '%s': %v", name, err)
+		}
+		files = append(files, map[string]interface{}{"filename": f.Filename, "hash": f.Hash})
+	}
+
+	d.SetId(name)
+	_ = d.Set("agent_count", g.Count)
+	_ = d.Set("config_sum", g.ConfigSum)
+	_ = d.Set("merged_sum", g.MergedSum)
+	_ = d.Set("agent_ids", agentIDs)
+	if err := d.Set("files", files); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for attr, file := range map[string]string{"agent_conf": "agent.conf", "merged_mg": "merged.mg"} {
+		content := ""
+		if d.Get("include_" + attr).(bool) {
+			path := fmt.Sprintf("groups/%s/files/%s", url.PathEscape(name), file)
+			body, err := client.doRawRequest(ctx, http.MethodGet, path, url.Values{"raw": {"true"}}, nil, "")
+			if err != nil {
+				return diag.Errorf("failed to read %s of Wazuh group '%s': %v", file, name, err)
+			}
+			content = string(body)
+		}
+		_ = d.Set(attr, content)
+	}
+
+	return nil
+}
+
+// groupAgentIDs returns the IDs of all agents assigned to a group.
+func groupAgentIDs(ctx context.Context, client *APIClient, group string) ([]string, error) {
+	path := fmt.Sprintf("groups/%s/agents", url.PathEscape(group))
+	items, err := client.listAffectedItems(ctx, path, url.Values{"select": {"id"}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agents of Wazuh group '%s': %w", group, err)
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, raw := range items {
+		var a struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(raw, &a); err != nil {
+			return nil, fmt.Errorf("failed to parse agents of Wazuh group '%s': %w", group, err)
+		}
+		ids = append(ids, a.ID)
+	}
+	return ids, nil
+}
@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceGroups lists Wazuh agent groups via:
+//   - GET /groups                   (Read, paged)
+//   - GET /groups/{group_id}/agents (Read, member agent IDs per group)
+func dataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only groups whose fields contain this string.",
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Wazuh query language filter, e.g. \"count>0\".",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the matching groups.",
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching groups.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":        {Type: schema.TypeString, Computed: true, Description: "Group name."},
+						"agent_count": {Type: schema.TypeInt, Computed: true, Description: "Number of agents in the group."},
+						"config_sum":  {Type: schema.TypeString, Computed: true, Description: "Checksum of agent.conf (configSum)."},
+						"merged_sum":  {Type: schema.TypeString, Computed: true, Description: "Checksum of merged.mg (mergedSum)."},
+						"agent_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the agents in the group.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	})
+
+	items, err := client.listAffectedItems(ctx, "groups", q)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh groups: %v", err)
+	}
+
+	names := make([]string, 0, len(items))
+	groups := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var g apiGroup
+		if err := json.Unmarshal(raw, &g); err != nil {
+			return diag.Errorf("failed to parse Wazuh group: %v", err)
+		}
+
+		agentIDs := []string{}
+		if g.Count > 0 {
+			agentIDs, err = groupAgentIDs(ctx, client, g.Name)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		names = append(names, g.Name)
+		groups = append(groups, map[string]interface{}{
+			"name":        g.Name,
+			"agent_count": g.Count,
+			"config_sum":  g.ConfigSum,
+			"merged_sum":  g.MergedSum,
+			"agent_ids":   agentIDs,
+		})
+	}
+
+	d.SetId(dataSourceQueryID("groups", q))
+	_ = d.Set("names", names)
+	if err := d.Set("groups", groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
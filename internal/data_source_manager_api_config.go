@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceManagerAPIConfig reads the active Wazuh API configuration via:
+//   - GET /manager/api/config                       (Read)
+//   - GET /cluster/api/config?nodes_list=<node_id>  (Read, when node_id is set)
+func dataSourceManagerAPIConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceManagerAPIConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+
+			"config_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Complete API configuration as JSON (use jsondecode() for fields not exposed as attributes).",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Port the API listens on.",
+			},
+			"https_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether HTTPS is enabled.",
+			},
+			"logs_level": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API log level.",
+			},
+			"max_login_attempts": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum login attempts before the IP is blocked.",
+			},
+			"block_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Seconds an IP stays blocked after too many login attempts.",
+			},
+			"max_request_per_minute": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum number of requests per minute.",
+			},
+			"drop_privileges": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the API runs as the wazuh user.",
+			},
+			"experimental_features": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether experimental endpoints (e.g. /experimental/syscollector) are enabled.",
+			},
+		},
+	}
+}
+
+// apiConfig is the subset of node_api_config exposed as attributes.
+type apiConfig struct {
+	Port  int `json:"port"`
+	HTTPS struct {
+		Enabled bool `json:"enabled"`
+	} `json:"https"`
+	Logs struct {
+		Level string `json:"level"`
+	} `json:"logs"`
+	Access struct {
+		MaxLoginAttempts    int `json:"max_login_attempts"`
+		BlockTime           int `json:"block_time"`
+		MaxRequestPerMinute int `json:"max_request_per_minute"`
+	} `json:"access"`
+	DropPrivileges       bool `json:"drop_privileges"`
+	ExperimentalFeatures bool `json:"experimental_features"`
+}
+
+func dataSourceManagerAPIConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	nodeID := d.Get("node_id").(string)
+
+	path := "manager/api/config"
+	var q url.Values
+	if nodeID != "" {
+		path = "cluster/api/config"
+		q = url.Values{"nodes_list": {nodeID}}
+	}
+
+	raw, err := firstAffectedItem(ctx, client, path, q)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh API configuration: %v", err)
+	}
+	var item struct {
+		NodeAPIConfig json.RawMessage `json:"node_api_config"`
+	}
+	if err := json.Unmarshal(raw, &item); err != nil || len(item.NodeAPIConfig) == 0 {
+		return diag.Errorf("failed to parse Wazuh API configuration: %s", string(raw))
+	}
+	var cfg apiConfig
+	if err := json.Unmarshal(item.NodeAPIConfig, &cfg); err != nil {
+		return diag.Errorf("failed to parse Wazuh API configuration: %v", err)
+	}
+
+	d.SetId(dataSourceQueryID("api-config", url.Values{"node_id": {nodeID}}))
+	_ = d.Set("config_json", string(item.NodeAPIConfig))
+	_ = d.Set("port", cfg.Port)
+	_ = d.Set("https_enabled", cfg.HTTPS.Enabled)
+	_ = d.Set("logs_level", cfg.Logs.Level)
+	_ = d.Set("max_login_attempts", cfg.Access.MaxLoginAttempts)
+	_ = d.Set("block_time", cfg.Access.BlockTime)
+	_ = d.Set("max_request_per_minute", cfg.Access.MaxRequestPerMinute)
+	_ = d.Set("drop_privileges", cfg.DropPrivileges)
+	_ = d.Set("experimental_features", cfg.ExperimentalFeatures)
+
+	return nil
+}
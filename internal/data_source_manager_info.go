@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceManagerInfo reads basic manager information via:
+//   - GET /manager/info            (Read)
+//   - GET /cluster/{node_id}/info  (Read, when node_id is set)
+func dataSourceManagerInfo() *schema.Resource {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceManagerInfoRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+
+			"version":          str("Wazuh version, e.g. v4.12.0."),
+			"type":             str("Installation type (server)."),
+			"path":             str("Installation path, e.g. /var/ossec."),
+			"compilation_date": str("Compilation date (only reported by some versions)."),
+			"max_agents":       str("Maximum number of agents (\"unlimited\" by default)."),
+			"openssl_support":  str("Whether OpenSSL support is compiled in (yes/no)."),
+			"tz_offset":        str("Time zone offset of the manager."),
+			"tz_name":          str("Time zone name of the manager."),
+		},
+	}
+}
+
+func dataSourceManagerInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "info")
+
+	raw, err := firstAffectedItem(ctx, client, path, nil)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh manager info: %v", err)
+	}
+	var info map[string]interface{}
+	if err := json.Unmarshal(raw, &info); err != nil {
+		return diag.Errorf("failed to parse Wazuh manager info: %v", err)
+	}
+
+	d.SetId(path)
+	for _, attr := range []string{"version", "type", "path", "compilation_date", "max_agents", "openssl_support", "tz_offset", "tz_name"} {
+		_ = d.Set(attr, scalarString(info[attr]))
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceManagerLogs reads ossec.log entries via:
+//   - GET /manager/logs            (Read, paged)
+//   - GET /cluster/{node_id}/logs  (Read, paged, when node_id is set)
+func dataSourceManagerLogs() *schema.Resource {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceManagerLogsRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+
+			// ---- Filters ----
+			"level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by level: critical, error, warning, info, debug or debug2.",
+			},
+			"tag": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by daemon tag, e.g. wazuh-analysisd.",
+			},
+			"since": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only entries newer than this: an RFC 3339 timestamp or a duration before now, e.g. \"30m\".",
+			},
+			"until": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only entries older than this: an RFC 3339 timestamp or a duration before now.",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only entries containing this string.",
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Wazuh query language filter.",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"entries": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching log entries, newest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp":   str("Time of the entry."),
+						"tag":         str("Daemon that logged the entry."),
+						"level":       str("Log level."),
+						"description": str("Log message."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// logTimeBound converts the since/until arguments into a timestamp for q.
+func logTimeBound(v string, now time.Time) (string, error) {
+	if d, err := time.ParseDuration(v); err == nil {
+		return now.Add(-d).UTC().Format(time.RFC3339), nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return "", err
+	}
+	return t.UTC().Format(time.RFC3339), nil
+}
+
+func dataSourceManagerLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "logs")
+
+	q := buildQuery(d, []queryParam{
+		{"level", "level"},
+		{"tag", "tag"},
+		{"search", "search"},
+		{"limit", "limit"},
+	})
+	q.Set("sort", "-timestamp")
+
+	// The ID is built from the raw arguments: relative since/until values
+	// resolve to a new timestamp on every read.
+	idQuery := buildQuery(d, []queryParam{
+		{"q", "q"},
+		{"since", "since"},
+		{"until", "until"},
+	})
+	for k, v := range q {
+		idQuery[k] = v
+	}
+
+	var conditions []string
+	if v := d.Get("q").(string); v != "" {
+		// Parenthesized so a user "," (OR) does not bind with the time bounds.
+		conditions = append(conditions, "("+v+")")
+	}
+	now := time.Now()
+	for _, bound := range []struct{ attr, op string }{{"since", ">"}, {"until", "<"}} {
+		v := d.Get(bound.attr).(string)
+		if v == "" {
+			continue
+		}
+		ts, err := logTimeBound(v, now)
+		if err != nil {
+			return diag.Errorf("invalid %s '%s': expected an RFC 3339 timestamp or a duration", bound.attr, v)
+		}
+		conditions = append(conditions, "timestamp"+bound.op+ts)
+	}
+	if len(conditions) > 0 {
+		q.Set("q", strings.Join(conditions, ";"))
+	}
+
+	items, err := client.listAffectedItems(ctx, path, q)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh logs: %v", err)
+	}
+
+	entries := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var e struct {
+			Timestamp   string `json:"timestamp"`
+			Tag         string `json:"tag"`
+			Level       string `json:"level"`
+			Description string `json:"description"`
+		}
+		if err := json.Unmarshal(raw, &e); err != nil {
+			return diag.Errorf("failed to parse Wazuh log entry: %v", err)
+		}
+		entries = append(entries, map[string]interface{}{
+			"timestamp":   e.Timestamp,
+			"tag":         e.Tag,
+			"level":       e.Level,
+			"description": e.Description,
+		})
+	}
+
+	d.SetId(dataSourceQueryID(path, idQuery))
+	if err := d.Set("entries", entries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
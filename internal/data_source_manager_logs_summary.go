@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceManagerLogsSummary reads per-daemon log counters via:
+//   - GET /manager/logs/summary            (Read)
+//   - GET /cluster/{node_id}/logs/summary  (Read, when node_id is set)
+func dataSourceManagerLogsSummary() *schema.Resource {
+	num := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeInt, Computed: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceManagerLogsSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+
+			"errors":   num("Total error and critical entries over all tags."),
+			"warnings": num("Total warning entries over all tags."),
+			"tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Counters per daemon tag, sorted by tag.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag":      {Type: schema.TypeString, Computed: true, Description: "Daemon tag, e.g. wazuh-analysisd."},
+						"all":      num("All entries."),
+						"critical": num("Critical entries."),
+						"error":    num("Error entries."),
+						"warning":  num("Warning entries."),
+						"info":     num("Info entries."),
+						"debug":    num("Debug entries."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagerLogsSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "logs/summary")
+
+	// Every affected item is an object with a single tag key.
+	var result struct {
+		Data struct {
+			AffectedItems []map[string]map[string]int `json:"affected_items"`
+		} `json:"data"`
+	}
+	if err := client.doJSONRequest(ctx, http.MethodGet, path, nil, nil, &result); err != nil {
+		return diag.Errorf("failed to read Wazuh logs summary: %v", err)
+	}
+
+	counters := map[string]map[string]int{}
+	for _, item := range result.Data.AffectedItems {
+		for tag, c := range item {
+			counters[tag] = c
+		}
+	}
+	names := make([]string, 0, len(counters))
+	for tag := range counters {
+		names = append(names, tag)
+	}
+	sort.Strings(names)
+
+	errors, warnings := 0, 0
+	tags := make([]map[string]interface{}, 0, len(names))
+	for _, tag := range names {
+		c := counters[tag]
+		errors += c["error"] + c["critical"]
+		warnings += c["warning"]
+		tags = append(tags, map[string]interface{}{
+			"tag":      tag,
+			"all":      c["all"],
+			"critical": c["critical"],
+			"error":    c["error"],
+			"warning":  c["warning"],
+			"info":     c["info"],
+			"debug":    c["debug"],
+		})
+	}
+
+	d.SetId(path)
+	_ = d.Set("errors", errors)
+	_ = d.Set("warnings", warnings)
+	if err := d.Set("tags", tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceManagerStats reads the alert and event statistics of one day via:
+//   - GET /manager/stats            (Read)
+//   - GET /cluster/{node_id}/stats  (Read, when node_id is set)
+func dataSourceManagerStats() *schema.Resource {
+	num := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeInt, Computed: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceManagerStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+			"date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Day to read (YYYY-MM-DD). Defaults to today.",
+			},
+
+			// ---- Results ----
+			"total_alerts": num("Alerts generated during the day."),
+			"events":       num("Events processed during the day."),
+			"syscheck":     num("Syscheck events processed during the day."),
+			"firewall":     num("Firewall events processed during the day."),
+			"alerts_by_rule": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Alerts during the day per rule ID.",
+			},
+			"alerts_by_level": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Alerts during the day per rule level.",
+			},
+			"hours": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Statistics per hour, in hour order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hour":         num("Hour of the day (0-23)."),
+						"total_alerts": num("Alerts generated during the hour."),
+						"events":       num("Events processed during the hour."),
+						"syscheck":     num("Syscheck events processed during the hour."),
+						"firewall":     num("Firewall events processed during the hour."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// managerStatsHour is one element of GET /manager/stats.
+type managerStatsHour struct {
+	Hour   int `json:"hour"`
+	Alerts []struct {
+		SigID int `json:"sigid"`
+		Level int `json:"level"`
+		Times int `json:"times"`
+	} `json:"alerts"`
+	TotalAlerts int `json:"totalAlerts"`
+	Events      int `json:"events"`
+	Syscheck    int `json:"syscheck"`
+	Firewall    int `json:"firewall"`
+}
+
+func dataSourceManagerStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "stats")
+	q := buildQuery(d, []queryParam{{"date", "date"}})
+
+	items, err := affectedItems(ctx, client, path, q)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh statistics: %v", err)
+	}
+
+	parsed := make([]managerStatsHour, 0, len(items))
+	for _, raw := range items {
+		var h managerStatsHour
+		if err := json.Unmarshal(raw, &h); err != nil {
+			return diag.Errorf("failed to parse Wazuh statistics: %v", err)
+		}
+		parsed = append(parsed, h)
+	}
+	sort.SliceStable(parsed, func(i, j int) bool { return parsed[i].Hour < parsed[j].Hour })
+
+	var totalAlerts, events, syscheck, firewall int
+	byRule := map[string]int{}
+	byLevel := map[string]int{}
+	hours := make([]map[string]interface{}, 0, len(parsed))
+	for _, h := range parsed {
+		totalAlerts += h.TotalAlerts
+		events += h.Events
+		syscheck += h.Syscheck
+		firewall += h.Firewall
+		for _, a := range h.Alerts {
+			byRule[strconv.Itoa(a.SigID)] += a.Times
+			byLevel[strconv.Itoa(a.Level)] += a.Times
+		}
+		hours = append(hours, map[string]interface{}{
+			"hour":         h.Hour,
+			"total_alerts": h.TotalAlerts,
+			"events":       h.Events,
+			"syscheck":     h.Syscheck,
+			"firewall":     h.Firewall,
+		})
+	}
+
+	d.SetId(dataSourceQueryID(path, q))
+	_ = d.Set("total_alerts", totalAlerts)
+	_ = d.Set("events", events)
+	_ = d.Set("syscheck", syscheck)
+	_ = d.Set("firewall", firewall)
+	if err := d.Set("alerts_by_rule", byRule); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("alerts_by_level", byLevel); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hours", hours); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// dataSourceManagerStatsHourly reads the average number of events per hour via:
+//   - GET /manager/stats/hourly            (Read)
+//   - GET /cluster/{node_id}/stats/hourly  (Read, when node_id is set)
+func dataSourceManagerStatsHourly() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceManagerStatsHourlyRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+
+			"averages": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Average number of events for each hour of the day (index 0-23).",
+			},
+			"interactions": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of days the averages are based on.",
+			},
+		},
+	}
+}
+
+func dataSourceManagerStatsHourlyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "stats/hourly")
+
+	raw, err := firstAffectedItem(ctx, client, path, nil)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh hourly statistics: %v", err)
+	}
+	var item struct {
+		Averages     []int `json:"averages"`
+		Interactions int   `json:"interactions"`
+	}
+	if err := json.Unmarshal(raw, &item); err != nil {
+		return diag.Errorf("failed to parse Wazuh hourly statistics: %v", err)
+	}
+
+	d.SetId(path)
+	if err := d.Set("averages", item.Averages); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("interactions", item.Interactions)
+
+	return nil
+}
+
+// dataSourceManagerStatsWeekly reads the average number of events per weekday
+// and hour via:
+//   - GET /manager/stats/weekly            (Read)
+//   - GET /cluster/{node_id}/stats/weekly  (Read, when node_id is set)
+func dataSourceManagerStatsWeekly() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceManagerStatsWeeklyRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+
+			"days": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Averages per weekday, Sunday first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Weekday (Sun, Mon, ...).",
+						},
+						"hours": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Average number of events for each hour of the day (index 0-23).",
+						},
+						"interactions": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of weeks the averages are based on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagerStatsWeeklyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "stats/weekly")
+
+	items, err := affectedItems(ctx, client, path, nil)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh weekly statistics: %v", err)
+	}
+
+	// Every affected item is an object with a single weekday key.
+	days := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var item map[string]struct {
+			Hours        []int `json:"hours"`
+			Interactions int   `json:"interactions"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return diag.Errorf("failed to parse Wazuh weekly statistics: %v", err)
+		}
+		for day, s := range item {
+			days = append(days, map[string]interface{}{
+				"day":          day,
+				"hours":        s.Hours,
+				"interactions": s.Interactions,
+			})
+		}
+	}
+
+	d.SetId(path)
+	if err := d.Set("days", days); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
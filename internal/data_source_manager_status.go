@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceManagerStatus reads the state of the manager daemons via:
+//   - GET /manager/status            (Read)
+//   - GET /cluster/{node_id}/status  (Read, when node_id is set)
+func dataSourceManagerStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceManagerStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"node_id": nodeIDSchema(),
+
+			"daemons": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "State of each daemon (running, stopped, ...), keyed by daemon name.",
+			},
+			"running": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the running daemons.",
+			},
+			"stopped": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the daemons that are not running.",
+			},
+		},
+	}
+}
+
+func dataSourceManagerStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	path := nodeScopedPath(d.Get("node_id").(string), "status")
+
+	raw, err := firstAffectedItem(ctx, client, path, nil)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh manager status: %v", err)
+	}
+	var daemons map[string]string
+	if err := json.Unmarshal(raw, &daemons); err != nil {
+		return diag.Errorf("failed to parse Wazuh manager status: %v", err)
+	}
+
+	running := []string{}
+	stopped := []string{}
+	for name, state := range daemons {
+		if state == "running" {
+			running = append(running, name)
+		} else {
+			stopped = append(stopped, name)
+		}
+	}
+	sort.Strings(running)
+	sort.Strings(stopped)
+
+	d.SetId(path)
+	_ = d.Set("daemons", daemons)
+	_ = d.Set("running", running)
+	_ = d.Set("stopped", stopped)
+
+	return nil
+}
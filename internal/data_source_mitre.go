@@ -0,0 +1,337 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mitreKind describes one MITRE ATT&CK catalog served under /mitre. Every
+// kind is read by one data source via GET /mitre/{Type} (paged).
+type mitreKind struct {
+	Type     string // API path element and list attribute, e.g. "tactics"
+	IDsParam string // e.g. "tactic_ids"
+	Label    string // used in messages, e.g. "tactics"
+
+	// Relations are list fields referencing other catalogs, e.g.
+	// {"technique_ids", "techniques"} for tactics.
+	Relations []mitreRelation
+}
+
+type mitreRelation struct {
+	Attr        string
+	Field       string
+	Description string
+
+	// Catalog holds the items referenced by Field. Wazuh reports relations
+	// as STIX IDs (attack-pattern--…), which are translated to ATT&CK IDs
+	// through this catalog. Empty for plain values such as platforms.
+	Catalog string
+}
+
+// mitreIDResolver translates STIX IDs to ATT&CK IDs. Catalogs are read once
+// per resolver, so one resolver should be shared within a read.
+type mitreIDResolver struct {
+	client   *APIClient
+	catalogs map[string]map[string]string // catalog -> STIX ID -> ATT&CK ID
+}
+
+func newMitreIDResolver(client *APIClient) *mitreIDResolver {
+	return &mitreIDResolver{client: client, catalogs: map[string]map[string]string{}}
+}
+
+// catalog returns the STIX ID -> ATT&CK ID map of a catalog via GET /mitre/{catalog}.
+func (r *mitreIDResolver) catalog(ctx context.Context, name string) (map[string]string, error) {
+	if ids, ok := r.catalogs[name]; ok {
+		return ids, nil
+	}
+	// Only the fields mitreID reads; full items carry long descriptions.
+	q := url.Values{"select": {"id,external_id,references"}}
+	items, err := r.client.listAffectedItems(ctx, "mitre/"+name, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MITRE ATT&CK %s: %w", name, err)
+	}
+	ids := make(map[string]string, len(items))
+	for _, raw := range items {
+		var item map[string]interface{}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, err
+		}
+		if stixID := scalarString(item["id"]); stixID != "" {
+			ids[stixID] = mitreID(item)
+		}
+	}
+	r.catalogs[name] = ids
+	return ids, nil
+}
+
+// stixIDs translates ATT&CK IDs to the STIX IDs the API filters on. IDs
+// missing from the catalog are passed through unchanged.
+func (r *mitreIDResolver) stixIDs(ctx context.Context, name string, ids []string) ([]string, error) {
+	catalog, err := r.catalog(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	stix := make(map[string]string, len(catalog))
+	for stixID, id := range catalog {
+		stix[id] = stixID
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if stixID, ok := stix[id]; ok {
+			id = stixID
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+func (k mitreKind) dataSource() *schema.Resource {
+	item := map[string]*schema.Schema{
+		"id":          {Type: schema.TypeString, Computed: true, Description: "ATT&CK ID, e.g. TA0006 or T1110."},
+		"name":        {Type: schema.TypeString, Computed: true, Description: "Name."},
+		"description": {Type: schema.TypeString, Computed: true, Description: "Description."},
+	}
+	for _, r := range k.Relations {
+		item[r.Attr] = &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: r.Description,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return k.read(ctx, d, meta)
+		},
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			k.IDsParam: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Only the %s with these IDs.", k.Label),
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Only %s whose fields contain this string.", k.Label),
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Wazuh query language filter.",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("IDs of the matching %s.", k.Label),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the matching %s.", k.Label),
+			},
+			k.Type: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("Matching %s.", k.Label),
+				Elem:        &schema.Resource{Schema: item},
+			},
+		},
+	}
+}
+
+func (k mitreKind) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{k.IDsParam, k.IDsParam},
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	})
+
+	objects, err := k.list(ctx, newMitreIDResolver(client), q)
+	if err != nil {
+		return diag.Errorf("failed to list MITRE ATT&CK %s: %v", k.Label, err)
+	}
+
+	ids := make([]string, 0, len(objects))
+	names := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		ids = append(ids, obj["id"].(string))
+		names = append(names, obj["name"].(string))
+	}
+
+	d.SetId(dataSourceQueryID("mitre-"+k.Type, q))
+	_ = d.Set("ids", ids)
+	_ = d.Set("names", names)
+	if err := d.Set(k.Type, objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// list reads the catalog and flattens every item into the data source
+// attributes. IDs in q[k.IDsParam] and in the relations are ATT&CK IDs.
+func (k mitreKind) list(ctx context.Context, resolver *mitreIDResolver, q url.Values) ([]map[string]interface{}, error) {
+	apiQuery := url.Values{}
+	for key, v := range q {
+		apiQuery[key] = v
+	}
+	if ids := q.Get(k.IDsParam); ids != "" {
+		stixIDs, err := resolver.stixIDs(ctx, k.Type, strings.Split(ids, ","))
+		if err != nil {
+			return nil, err
+		}
+		apiQuery.Set(k.IDsParam, strings.Join(stixIDs, ","))
+	}
+	items, err := resolver.client.listAffectedItems(ctx, "mitre/"+k.Type, apiQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := len(q) == 0 && resolver.catalogs[k.Type] == nil
+	if seed {
+		resolver.catalogs[k.Type] = make(map[string]string, len(items))
+	}
+
+	catalogs := map[string]map[string]string{}
+	for _, r := range k.Relations {
+		if r.Catalog == "" {
+			continue
+		}
+		if catalogs[r.Catalog], err = resolver.catalog(ctx, r.Catalog); err != nil {
+			return nil, err
+		}
+	}
+
+	objects := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var item map[string]interface{}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, err
+		}
+		if seed {
+			resolver.catalogs[k.Type][scalarString(item["id"])] = mitreID(item)
+		}
+		obj := map[string]interface{}{
+			"id":          mitreID(item),
+			"name":        scalarString(item["name"]),
+			"description": scalarString(item["description"]),
+		}
+		for _, r := range k.Relations {
+			obj[r.Attr] = mitreIDList(item[r.Field], catalogs[r.Catalog])
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// mitreID returns the ATT&CK ID (TA0006, T1110, G0016, M1036) of a catalog
+// item. Depending on the Wazuh version it is reported as "external_id" or in
+// the "mitre-attack" reference next to a STIX "id", or directly as "id".
+func mitreID(item map[string]interface{}) string {
+	if id := scalarString(item["external_id"]); id != "" {
+		return id
+	}
+	refs, _ := item["references"].([]interface{})
+	for _, e := range refs {
+		ref, _ := e.(map[string]interface{})
+		if scalarString(ref["source"]) == "mitre-attack" {
+			if id := scalarString(ref["external_id"]); id != "" {
+				return id
+			}
+		}
+	}
+	return scalarString(item["id"])
+}
+
+// mitreIDList flattens a relation field, which holds either plain IDs or
+// objects carrying an ID, translating STIX IDs found in catalog.
+func mitreIDList(v interface{}, catalog map[string]string) []string {
+	list, _ := v.([]interface{})
+	ids := make([]string, 0, len(list))
+	for _, e := range list {
+		var id string
+		switch t := e.(type) {
+		case map[string]interface{}:
+			id = mitreID(t)
+			if stixID := scalarString(t["id"]); id == stixID && catalog[stixID] != "" {
+				id = catalog[stixID]
+			}
+		default:
+			id = scalarString(t)
+			if catalog[id] != "" {
+				id = catalog[id]
+			}
+		}
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+var mitreTactics = mitreKind{
+	Type: "tactics", IDsParam: "tactic_ids", Label: "tactics",
+	Relations: []mitreRelation{
+		{"technique_ids", "techniques", "IDs of the techniques of the tactic.", "techniques"},
+	},
+}
+
+var mitreTechniques = mitreKind{
+	Type: "techniques", IDsParam: "technique_ids", Label: "techniques",
+	Relations: []mitreRelation{
+		{"tactic_ids", "tactics", "IDs of the tactics the technique belongs to.", "tactics"},
+		{"mitigation_ids", "mitigations", "IDs of the mitigations of the technique.", "mitigations"},
+		{"group_ids", "groups", "IDs of the groups known to use the technique.", "groups"},
+		{"software_ids", "software", "IDs of the software known to use the technique.", "software"},
+		{"platforms", "platforms", "Platforms the technique applies to.", ""},
+	},
+}
+
+var mitreGroups = mitreKind{
+	Type: "groups", IDsParam: "group_ids", Label: "groups",
+	Relations: []mitreRelation{
+		{"technique_ids", "techniques", "IDs of the techniques used by the group.", "techniques"},
+		{"software_ids", "software", "IDs of the software used by the group.", "software"},
+	},
+}
+
+var mitreMitigations = mitreKind{
+	Type: "mitigations", IDsParam: "mitigation_ids", Label: "mitigations",
+	Relations: []mitreRelation{
+		{"technique_ids", "techniques", "IDs of the techniques the mitigation addresses.", "techniques"},
+	},
+}
+
+func dataSourceMitreTactics() *schema.Resource {
+	return mitreTactics.dataSource()
+}
+
+func dataSourceMitreTechniques() *schema.Resource {
+	return mitreTechniques.dataSource()
+}
+
+func dataSourceMitreGroups() *schema.Resource {
+	return mitreGroups.dataSource()
+}
+
+func dataSourceMitreMitigations() *schema.Resource {
+	return mitreMitigations.dataSource()
+}
@@ -0,0 +1,246 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceMitreCoverage reports which ATT&CK techniques the ruleset detects via:
+//   - GET /rules?select=id,mitre (Read, paged, rules with MITRE mappings)
+//   - GET /mitre/techniques      (Read, paged, technique catalog)
+//   - GET /mitre/tactics         (Read, paged, tactic catalog)
+func dataSourceMitreCoverage() *schema.Resource {
+	ids := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceMitreCoverageRead,
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			"rule_status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "enabled",
+				Description: "Only count rules with this status: enabled (default), disabled or all.",
+			},
+			"rule_q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Wazuh query language filter applied to the rules, e.g. \"level>=7\".",
+			},
+			"tactic_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only report these tactics, e.g. [\"TA0006\"].",
+			},
+
+			// ---- Results ----
+			"covered_technique_ids":   ids("Sorted IDs of the techniques detected by at least one rule."),
+			"uncovered_technique_ids": ids("Sorted IDs of the techniques not detected by any rule."),
+			"coverage_percent": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Covered techniques in percent of all reported techniques.",
+			},
+			"tactics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Coverage per tactic.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":                      {Type: schema.TypeString, Computed: true, Description: "Tactic ID."},
+						"name":                    {Type: schema.TypeString, Computed: true, Description: "Tactic name."},
+						"covered_technique_ids":   ids("Covered techniques of the tactic."),
+						"uncovered_technique_ids": ids("Uncovered techniques of the tactic."),
+						"covered_count":           {Type: schema.TypeInt, Computed: true, Description: "Number of covered techniques."},
+						"total_count":             {Type: schema.TypeInt, Computed: true, Description: "Number of techniques of the tactic."},
+						"coverage_percent":        {Type: schema.TypeFloat, Computed: true, Description: "Covered techniques in percent."},
+					},
+				},
+			},
+			"covered_techniques": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Covered techniques with the rules detecting them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":   {Type: schema.TypeString, Computed: true, Description: "Technique ID."},
+						"name": {Type: schema.TypeString, Computed: true, Description: "Technique name."},
+						"rule_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "IDs of the rules mapped to the technique or one of its sub-techniques.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMitreCoverageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	ruleQuery := buildQuery(d, []queryParam{
+		{"rule_status", "status"},
+		{"rule_q", "q"},
+	})
+	ruleQuery.Set("select", "id,mitre")
+	ruleItems, err := client.listAffectedItems(ctx, "rules", ruleQuery)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh rules: %v", err)
+	}
+
+	// technique ID -> rule IDs. A rule mapped to a sub-technique (T1110.001)
+	// also covers its parent technique (T1110).
+	rulesByTechnique := map[string][]int{}
+	for _, raw := range ruleItems {
+		var r struct {
+			ID    int      `json:"id"`
+			Mitre []string `json:"mitre"`
+		}
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return diag.Errorf("failed to parse Wazuh rule: %v", err)
+		}
+		for _, t := range r.Mitre {
+			rulesByTechnique[t] = appendUniqueInt(rulesByTechnique[t], r.ID)
+			if parent, _, ok := strings.Cut(t, "."); ok {
+				rulesByTechnique[parent] = appendUniqueInt(rulesByTechnique[parent], r.ID)
+			}
+		}
+	}
+
+	// Both catalogs report their relations as STIX IDs; the resolver maps
+	// them to ATT&CK IDs so they compare against the rules' mitre IDs.
+	resolver := newMitreIDResolver(client)
+	techniques, err := mitreTechniques.list(ctx, resolver, url.Values{})
+	if err != nil {
+		return diag.Errorf("failed to list MITRE ATT&CK techniques: %v", err)
+	}
+	tacticQuery := buildQuery(d, []queryParam{{"tactic_ids", "tactic_ids"}})
+	tactics, err := mitreTactics.list(ctx, resolver, tacticQuery)
+	if err != nil {
+		return diag.Errorf("failed to list MITRE ATT&CK tactics: %v", err)
+	}
+
+	names := map[string]string{}
+	techniquesByTactic := map[string][]string{}
+	for _, t := range techniques {
+		id := t["id"].(string)
+		names[id] = t["name"].(string)
+		for _, tactic := range t["tactic_ids"].([]string) {
+			techniquesByTactic[tactic] = append(techniquesByTactic[tactic], id)
+		}
+	}
+
+	reported := map[string]bool{}
+	tacticCoverage := make([]map[string]interface{}, 0, len(tactics))
+	for _, tactic := range tactics {
+		id := tactic["id"].(string)
+		// Prefer the tactic's own technique list; fall back to the reverse
+		// mapping of the technique catalog.
+		members := tactic["technique_ids"].([]string)
+		if len(members) == 0 {
+			members = techniquesByTactic[id]
+		}
+		covered, uncovered := []string{}, []string{}
+		for _, t := range uniqueSorted(members) {
+			reported[t] = true
+			if len(rulesByTechnique[t]) > 0 {
+				covered = append(covered, t)
+			} else {
+				uncovered = append(uncovered, t)
+			}
+		}
+		tacticCoverage = append(tacticCoverage, map[string]interface{}{
+			"id":                      id,
+			"name":                    tactic["name"],
+			"covered_technique_ids":   covered,
+			"uncovered_technique_ids": uncovered,
+			"covered_count":           len(covered),
+			"total_count":             len(covered) + len(uncovered),
+			"coverage_percent":        percent(len(covered), len(covered)+len(uncovered)),
+		})
+	}
+
+	coveredIDs, uncoveredIDs := []string{}, []string{}
+	for t := range reported {
+		if len(rulesByTechnique[t]) > 0 {
+			coveredIDs = append(coveredIDs, t)
+		} else {
+			uncoveredIDs = append(uncoveredIDs, t)
+		}
+	}
+	sort.Strings(coveredIDs)
+	sort.Strings(uncoveredIDs)
+
+	coveredTechniques := make([]map[string]interface{}, 0, len(coveredIDs))
+	for _, t := range coveredIDs {
+		ruleIDs := rulesByTechnique[t]
+		sort.Ints(ruleIDs)
+		coveredTechniques = append(coveredTechniques, map[string]interface{}{
+			"id":       t,
+			"name":     names[t],
+			"rule_ids": ruleIDs,
+		})
+	}
+
+	idQuery := url.Values{}
+	for k, v := range ruleQuery {
+		idQuery[k] = v
+	}
+	for k, v := range tacticQuery {
+		idQuery[k] = v
+	}
+	d.SetId(dataSourceQueryID("mitre-coverage", idQuery))
+	_ = d.Set("covered_technique_ids", coveredIDs)
+	_ = d.Set("uncovered_technique_ids", uncoveredIDs)
+	_ = d.Set("coverage_percent", percent(len(coveredIDs), len(coveredIDs)+len(uncoveredIDs)))
+	if err := d.Set("tactics", tacticCoverage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("covered_techniques", coveredTechniques); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func appendUniqueInt(list []int, v int) []int {
+	for _, e := range list {
+		if e == v {
+			return list
+		}
+	}
+	return append(list, v)
+}
+
+func uniqueSorted(values []string) []string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// percent returns part/total in percent, truncated to two decimals.
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part*10000/total) / 100
+}
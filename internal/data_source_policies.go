@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// policyObjects describes RBAC policies for the wazuh_policy and wazuh_policies data sources:
+//   - GET /security/policies?policy_ids=<id> (Read by policy_id)
+//   - GET /security/policies?search=<name>   (Read by name / list, paged)
+var policyObjects = securityObjectKind{
+	Label:     "policy",
+	Plural:    "policies",
+	Path:      "security/policies",
+	IDsParam:  "policy_ids",
+	IDAttr:    "policy_id",
+	NameField: "name",
+
+	Attributes: func() map[string]*schema.Schema {
+		strs := func(desc string) *schema.Schema {
+			return &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Description: desc}
+		}
+		return map[string]*schema.Schema{
+			"id":        {Type: schema.TypeInt, Computed: true, Description: "Policy ID."},
+			"name":      {Type: schema.TypeString, Computed: true, Description: "Policy name."},
+			"policy":    {Type: schema.TypeString, Computed: true, Description: "Policy document as JSON."},
+			"actions":   strs("Actions of the policy."),
+			"resources": strs("Resources of the policy."),
+			"effect":    {Type: schema.TypeString, Computed: true, Description: "allow or deny."},
+			"role_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the roles the policy is linked to.",
+			},
+		}
+	},
+
+	Flatten: func(raw json.RawMessage) (map[string]interface{}, error) {
+		var p struct {
+			ID     int             `json:"id"`
+			Name   string          `json:"name"`
+			Policy json.RawMessage `json:"policy"`
+			Roles  []int           `json:"roles"`
+		}
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return nil, err
+		}
+		var doc struct {
+			Actions   []string `json:"actions"`
+			Resources []string `json:"resources"`
+			Effect    string   `json:"effect"`
+		}
+		if len(p.Policy) > 0 {
+			if err := json.Unmarshal(p.Policy, &doc); err != nil {
+				return nil, err
+			}
+		}
+		return map[string]interface{}{
+			"id":        p.ID,
+			"name":      p.Name,
+			"policy":    string(p.Policy),
+			"actions":   doc.Actions,
+			"resources": doc.Resources,
+			"effect":    doc.Effect,
+			"role_ids":  intList(p.Roles),
+		}, nil
+	},
+}
+
+func dataSourcePolicy() *schema.Resource {
+	return securityObjectDataSource(policyObjects)
+}
+
+func dataSourcePolicies() *schema.Resource {
+	return securityObjectsDataSource(policyObjects)
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// roleObjects describes RBAC roles for the wazuh_role and wazuh_roles data sources:
+//   - GET /security/roles?role_ids=<id> (Read by role_id)
+//   - GET /security/roles?search=<name> (Read by name / list, paged)
+var roleObjects = securityObjectKind{
+	Label:     "role",
+	Plural:    "roles",
+	Path:      "security/roles",
+	IDsParam:  "role_ids",
+	IDAttr:    "role_id",
+	NameField: "name",
+
+	Attributes: func() map[string]*schema.Schema {
+		ids := func(desc string) *schema.Schema {
+			return &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeInt}, Description: desc}
+		}
+		return map[string]*schema.Schema{
+			"id":         {Type: schema.TypeInt, Computed: true, Description: "Role ID."},
+			"name":       {Type: schema.TypeString, Computed: true, Description: "Role name."},
+			"policy_ids": ids("IDs of the policies linked to the role."),
+			"rule_ids":   ids("IDs of the security rules linked to the role."),
+			"user_ids":   ids("IDs of the users assigned to the role."),
+		}
+	},
+
+	Flatten: func(raw json.RawMessage) (map[string]interface{}, error) {
+		var r struct {
+			ID       int    `json:"id"`
+			Name     string `json:"name"`
+			Policies []int  `json:"policies"`
+			Rules    []int  `json:"rules"`
+			Users    []int  `json:"users"`
+		}
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"id":         r.ID,
+			"name":       r.Name,
+			"policy_ids": intList(r.Policies),
+			"rule_ids":   intList(r.Rules),
+			"user_ids":   intList(r.Users),
+		}, nil
+	},
+}
+
+func dataSourceRole() *schema.Resource {
+	return securityObjectDataSource(roleObjects)
+}
+
+func dataSourceRoles() *schema.Resource {
+	return securityObjectsDataSource(roleObjects)
+}
@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRules searches the loaded ruleset via:
+//   - GET /rules (Read, paged)
+func dataSourceRules() *schema.Resource {
+	filter := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true, Description: desc}
+	}
+	strList := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			"rule_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Only these rule IDs.",
+			},
+			"level":            filter("Filter by level, either a single level (\"10\") or an inclusive range (\"7-12\")."),
+			"group":            filter("Filter by rule group, e.g. \"authentication_failed\"."),
+			"filename":         filter("Filter by rule file name, e.g. \"0095-sshd_rules.xml\"."),
+			"relative_dirname": filter("Filter by directory, e.g. \"ruleset/rules\" (stock) or \"etc/rules\" (custom)."),
+			"status":           filter("Filter by status: enabled, disabled or all."),
+			"pci_dss":          filter("Filter by PCI DSS requirement, e.g. \"10.2.4\"."),
+			"gdpr":             filter("Filter by GDPR requirement, e.g. \"IV_35.7.d\"."),
+			"hipaa":            filter("Filter by HIPAA requirement, e.g. \"164.312.b\"."),
+			"nist_800_53":      filter("Filter by NIST 800-53 requirement, e.g. \"AU.14\"."),
+			"tsc":              filter("Filter by TSC requirement, e.g. \"CC6.1\"."),
+			"mitre":            filter("Filter by MITRE ATT&CK technique ID, e.g. \"T1110\"."),
+			"search":           filter("Only rules whose fields contain this string."),
+			"q":                filter("Wazuh query language filter, e.g. \"level>10\"."),
+			"limit":            dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the matching rules.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching rules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":               {Type: schema.TypeInt, Computed: true, Description: "Rule ID."},
+						"level":            {Type: schema.TypeInt, Computed: true, Description: "Rule level."},
+						"status":           {Type: schema.TypeString, Computed: true, Description: "enabled or disabled."},
+						"description":      {Type: schema.TypeString, Computed: true, Description: "Rule description."},
+						"groups":           strList("Groups of the rule."),
+						"filename":         {Type: schema.TypeString, Computed: true, Description: "File the rule is defined in."},
+						"relative_dirname": {Type: schema.TypeString, Computed: true, Description: "Directory of the rule file."},
+						"details": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Rule options (if_sid, match, regex, ...). Structured values are JSON encoded.",
+						},
+						"pci_dss":     strList("PCI DSS requirements."),
+						"gdpr":        strList("GDPR requirements."),
+						"hipaa":       strList("HIPAA requirements."),
+						"nist_800_53": strList("NIST 800-53 requirements."),
+						"tsc":         strList("TSC requirements."),
+						"mitre":       strList("MITRE ATT&CK technique IDs."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiRule is an element of data.affected_items of GET /rules.
+type apiRule struct {
+	ID              int                        `json:"id"`
+	Level           int                        `json:"level"`
+	Status          string                     `json:"status"`
+	Description     string                     `json:"description"`
+	Groups          []string                   `json:"groups"`
+	Filename        string                     `json:"filename"`
+	RelativeDirname string                     `json:"relative_dirname"`
+	Details         map[string]json.RawMessage `json:"details"`
+	PCIDSS          []string                   `json:"pci_dss"`
+	GDPR            []string                   `json:"gdpr"`
+	HIPAA           []string                   `json:"hipaa"`
+	NIST80053       []string                   `json:"nist_800_53"`
+	TSC             []string                   `json:"tsc"`
+	Mitre           []string                   `json:"mitre"`
+}
+
+func dataSourceRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{"rule_ids", "rule_ids"},
+		{"level", "level"},
+		{"group", "group"},
+		{"filename", "filename"},
+		{"relative_dirname", "relative_dirname"},
+		{"status", "status"},
+		{"pci_dss", "pci_dss"},
+		{"gdpr", "gdpr"},
+		{"hipaa", "hipaa"},
+		{"nist_800_53", "nist-800-53"},
+		{"tsc", "tsc"},
+		{"mitre", "mitre"},
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	})
+
+	items, err := client.listAffectedItems(ctx, "rules", q)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh rules: %v", err)
+	}
+
+	ids := make([]int, 0, len(items))
+	rules := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var r apiRule
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return diag.Errorf("failed to parse Wazuh rule: %v", err)
+		}
+		ids = append(ids, r.ID)
+		rules = append(rules, map[string]interface{}{
+			"id":               r.ID,
+			"level":            r.Level,
+			"status":           r.Status,
+			"description":      r.Description,
+			"groups":           r.Groups,
+			"filename":         r.Filename,
+			"relative_dirname": r.RelativeDirname,
+			"details":          flattenDetails(r.Details),
+			"pci_dss":          r.PCIDSS,
+			"gdpr":             r.GDPR,
+			"hipaa":            r.HIPAA,
+			"nist_800_53":      r.NIST80053,
+			"tsc":              r.TSC,
+			"mitre":            r.Mitre,
+		})
+	}
+
+	d.SetId(dataSourceQueryID("rules", q))
+	_ = d.Set("ids", ids)
+	if err := d.Set("rules", rules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSCAChecks lists the check results of one SCA policy of an agent via:
+//   - GET /sca/{agent_id}/checks/{policy_id} (Read, paged)
+func dataSourceSCAChecks() *schema.Resource {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	filter := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceSCAChecksRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent ID, e.g. \"001\".",
+			},
+			"policy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SCA policy ID, e.g. \"cis_ubuntu22-04\".",
+			},
+
+			// ---- Filters ----
+			"result":    filter("Filter by result: passed, failed or \"not applicable\"."),
+			"title":     filter("Filter by check title."),
+			"condition": filter("Filter by check condition (all, any, none)."),
+			"search":    filter("Only checks whose fields contain this string."),
+			"q":         filter("Wazuh query language filter."),
+			"limit":     dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"passed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of returned checks that passed.",
+			},
+			"failed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of returned checks that failed.",
+			},
+			"not_applicable": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of returned checks that are not applicable.",
+			},
+			"failed_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the returned checks that failed.",
+			},
+			"checks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching checks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":          {Type: schema.TypeInt, Computed: true, Description: "Check ID."},
+						"title":       str("Check title."),
+						"description": str("Check description."),
+						"rationale":   str("Why the check matters."),
+						"remediation": str("How to fix a failed check."),
+						"references":  str("Check references."),
+						"result":      str("passed, failed or not applicable."),
+						"reason":      str("Why the check is not applicable."),
+						"condition":   str("Check condition (all, any, none)."),
+						"compliance": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Compliance mappings, e.g. cis = 1.1.1.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key":   str("Standard, e.g. cis, cis_csc, pci_dss, nist_800_53."),
+									"value": str("Requirement(s) of the standard."),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiSCACheck is an element of data.affected_items of GET /sca/{agent_id}/checks/{policy_id}.
+type apiSCACheck struct {
+	ID          int    `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Rationale   string `json:"rationale"`
+	Remediation string `json:"remediation"`
+	References  string `json:"references"`
+	Result      string `json:"result"`
+	Reason      string `json:"reason"`
+	Condition   string `json:"condition"`
+	Compliance  []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"compliance"`
+}
+
+func dataSourceSCAChecksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	agentID := d.Get("agent_id").(string)
+	policyID := d.Get("policy_id").(string)
+
+	q := buildQuery(d, []queryParam{
+		{"result", "result"},
+		{"title", "title"},
+		{"condition", "condition"},
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	})
+
+	path := fmt.Sprintf("sca/%s/checks/%s", url.PathEscape(agentID), url.PathEscape(policyID))
+	items, err := client.listAffectedItems(ctx, path, q)
+	if err != nil {
+		return diag.Errorf("failed to list SCA checks of policy '%s' on Wazuh agent '%s': %v", policyID, agentID, err)
+	}
+
+	passed, failed, notApplicable := 0, 0, 0
+	failedIDs := []int{}
+	checks := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var c apiSCACheck
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return diag.Errorf("failed to parse SCA check of policy '%s': %v", policyID, err)
+		}
+		switch c.Result {
+		case "passed":
+			passed++
+		case "failed":
+			failed++
+			failedIDs = append(failedIDs, c.ID)
+		default:
+			notApplicable++
+		}
+
+		compliance := make([]map[string]interface{}, 0, len(c.Compliance))
+		for _, m := range c.Compliance {
+			compliance = append(compliance, map[string]interface{}{"key": m.Key, "value": m.Value})
+		}
+		checks = append(checks, map[string]interface{}{
+			"id":          c.ID,
+			"title":       c.Title,
+			"description": c.Description,
+			"rationale":   c.Rationale,
+			"remediation": c.Remediation,
+			"references":  c.References,
+			"result":      c.Result,
+			"reason":      c.Reason,
+			"condition":   c.Condition,
+			"compliance":  compliance,
+		})
+	}
+
+	q.Set("agent_id", agentID)
+	q.Set("policy_id", policyID)
+	d.SetId(dataSourceQueryID("sca-checks", q))
+	_ = d.Set("passed", passed)
+	_ = d.Set("failed", failed)
+	_ = d.Set("not_applicable", notApplicable)
+	_ = d.Set("failed_ids", failedIDs)
+	if err := d.Set("checks", checks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
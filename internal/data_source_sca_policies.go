@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSCAPolicies lists the SCA policy results of an agent via:
+//   - GET /sca/{agent_id} (Read, paged)
+func dataSourceSCAPolicies() *schema.Resource {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	num := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeInt, Computed: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceSCAPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent ID, e.g. \"001\".",
+			},
+
+			// ---- Filters ----
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter by policy name.",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only policies whose fields contain this string.",
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Wazuh query language filter, e.g. \"score<70\".",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"policy_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the matching policies.",
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching policies.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"policy_id":    str("Policy ID, e.g. \"cis_ubuntu22-04\"."),
+						"name":         str("Policy name."),
+						"description":  str("Policy description."),
+						"references":   str("Policy references."),
+						"pass":         num("Number of passed checks."),
+						"fail":         num("Number of failed checks."),
+						"invalid":      num("Number of not applicable (invalid) checks."),
+						"total_checks": num("Total number of checks."),
+						"score":        num("Score in percent: pass / (pass + fail)."),
+						"start_scan":   str("Start of the last scan."),
+						"end_scan":     str("End of the last scan."),
+						"hash_file":    str("Hash of the policy file."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiSCAPolicy is an element of data.affected_items of GET /sca/{agent_id}.
+type apiSCAPolicy struct {
+	PolicyID    string `json:"policy_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	References  string `json:"references"`
+	Pass        int    `json:"pass"`
+	Fail        int    `json:"fail"`
+	Invalid     int    `json:"invalid"`
+	TotalChecks int    `json:"total_checks"`
+	Score       int    `json:"score"`
+	StartScan   string `json:"start_scan"`
+	EndScan     string `json:"end_scan"`
+	HashFile    string `json:"hash_file"`
+}
+
+func dataSourceSCAPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	agentID := d.Get("agent_id").(string)
+
+	q := buildQuery(d, []queryParam{
+		{"name", "name"},
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	})
+
+	items, err := client.listAffectedItems(ctx, fmt.Sprintf("sca/%s", url.PathEscape(agentID)), q)
+	if err != nil {
+		return diag.Errorf("failed to list SCA policies of Wazuh agent '%s': %v", agentID, err)
+	}
+
+	ids := make([]string, 0, len(items))
+	policies := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var p apiSCAPolicy
+		if err := json.Unmarshal(raw, &p); err != nil {
+			return diag.Errorf("failed to parse SCA policy of Wazuh agent '%s': %v", agentID, err)
+		}
+		ids = append(ids, p.PolicyID)
+		policies = append(policies, map[string]interface{}{
+			"policy_id":    p.PolicyID,
+			"name":         p.Name,
+			"description":  p.Description,
+			"references":   p.References,
+			"pass":         p.Pass,
+			"fail":         p.Fail,
+			"invalid":      p.Invalid,
+			"total_checks": p.TotalChecks,
+			"score":        p.Score,
+			"start_scan":   p.StartScan,
+			"end_scan":     p.EndScan,
+			"hash_file":    p.HashFile,
+		})
+	}
+
+	q.Set("agent_id", agentID)
+	d.SetId(dataSourceQueryID("sca", q))
+	_ = d.Set("policy_ids", ids)
+	if err := d.Set("policies", policies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
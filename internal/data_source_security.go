@@ -0,0 +1,205 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// securityObjectKind describes one of the RBAC object types served under
+// /security (users, roles, policies, rules). The singular and plural data
+// sources of every kind are built from it.
+type securityObjectKind struct {
+	Label     string // singular name used in messages, e.g. "role"
+	Plural    string // list attribute of the plural data source, e.g. "roles"
+	Path      string // e.g. "security/roles"
+	IDsParam  string // e.g. "role_ids"; also the ID filter of the plural data source
+	IDAttr    string // lookup attribute of the singular data source, e.g. "role_id"
+	NameField string // "name", or "username" for users
+
+	// Attributes returns the computed attributes of one object, including "id".
+	Attributes func() map[string]*schema.Schema
+	// Flatten converts one element of data.affected_items into Attributes.
+	Flatten func(raw json.RawMessage) (map[string]interface{}, error)
+}
+
+// intList returns a non-nil copy of ids for d.Set.
+func intList(ids []int) []int {
+	if ids == nil {
+		return []int{}
+	}
+	return ids
+}
+
+// securityObjectDataSource builds the singular data source of kind k, which
+// looks one object up by ID or exact name.
+func securityObjectDataSource(k securityObjectKind) *schema.Resource {
+	attrs := k.Attributes()
+	delete(attrs, "id")
+
+	attrs[k.IDAttr] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{k.IDAttr, k.NameField},
+		Description:  fmt.Sprintf("ID of the %s to look up.", k.Label),
+	}
+	attrs[k.NameField] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{k.IDAttr, k.NameField},
+		Description:  fmt.Sprintf("Exact %s of the %s to look up.", k.NameField, k.Label),
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return securityObjectRead(ctx, d, meta, k)
+		},
+		Schema: attrs,
+	}
+}
+
+func securityObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}, k securityObjectKind) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := url.Values{}
+	id := d.Get(k.IDAttr).(int)
+	name := d.Get(k.NameField).(string)
+	lookup := name
+	if id != 0 {
+		lookup = strconv.Itoa(id)
+		q.Set(k.IDsParam, lookup)
+	} else {
+		q.Set("search", name)
+	}
+
+	items, err := client.listAffectedItems(ctx, k.Path, q)
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh %s '%s': %v", k.Label, lookup, err)
+	}
+
+	var found map[string]interface{}
+	for _, raw := range items {
+		obj, err := k.Flatten(raw)
+		if err != nil {
+			return diag.Errorf("failed to parse Wazuh %s '%s': %v", k.Label, lookup, err)
+		}
+		// search matches substrings in any field, so compare the name exactly.
+		if id == 0 && obj[k.NameField] != name {
+			continue
+		}
+		found = obj
+		break
+	}
+	if found == nil {
+		return diag.Errorf("Wazuh %s '%s' not found", k.Label, lookup)
+	}
+
+	d.SetId(fmt.Sprint(found["id"]))
+	for attr, v := range found {
+		if attr == "id" {
+			attr = k.IDAttr
+		}
+		if err := d.Set(attr, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// securityObjectsDataSource builds the plural data source of kind k.
+func securityObjectsDataSource(k securityObjectKind) *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return securityObjectsRead(ctx, d, meta, k)
+		},
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			k.IDsParam: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: fmt.Sprintf("Only the %s with these IDs.", k.Plural),
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Only %s whose fields contain this string.", k.Plural),
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Wazuh query language filter.",
+			},
+			"limit": dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: fmt.Sprintf("IDs of the matching %s.", k.Plural),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the matching %s.", k.Plural),
+			},
+			k.Plural: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("Matching %s.", k.Plural),
+				Elem: &schema.Resource{
+					Schema: k.Attributes(),
+				},
+			},
+		},
+	}
+}
+
+func securityObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}, k securityObjectKind) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{k.IDsParam, k.IDsParam},
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	})
+
+	items, err := client.listAffectedItems(ctx, k.Path, q)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh %s: %v", k.Plural, err)
+	}
+
+	ids := make([]int, 0, len(items))
+	names := make([]string, 0, len(items))
+	objects := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		obj, err := k.Flatten(raw)
+		if err != nil {
+			return diag.Errorf("failed to parse Wazuh %s: %v", k.Label, err)
+		}
+		ids = append(ids, obj["id"].(int))
+		names = append(names, obj[k.NameField].(string))
+		objects = append(objects, obj)
+	}
+
+	d.SetId(dataSourceQueryID(k.Path, q))
+	_ = d.Set("ids", ids)
+	_ = d.Set("names", names)
+	if err := d.Set(k.Plural, objects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
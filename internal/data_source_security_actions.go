@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSecurityActions lists the RBAC actions usable in policies via:
+//   - GET /security/actions (Read)
+func dataSourceSecurityActions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecurityActionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only actions related to this API endpoint, e.g. \"GET /agents\".",
+			},
+
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted action names, e.g. \"agent:read\".",
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching actions, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":        {Type: schema.TypeString, Computed: true, Description: "Action name."},
+						"description": {Type: schema.TypeString, Computed: true, Description: "Action description."},
+						"resources": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types the action applies to, e.g. \"agent:id\".",
+						},
+						"related_endpoints": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "API endpoints guarded by the action.",
+						},
+						"example": {Type: schema.TypeString, Computed: true, Description: "Example policy using the action, as JSON."},
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiSecurityAction is a value of the data object of GET /security/actions,
+// which is keyed by action name.
+type apiSecurityAction struct {
+	Description      string          `json:"description"`
+	Resources        []string        `json:"resources"`
+	RelatedEndpoints []string        `json:"related_endpoints"`
+	Example          json.RawMessage `json:"example"`
+}
+
+func dataSourceSecurityActionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{"endpoint", "endpoint"},
+	})
+
+	var result struct {
+		Data map[string]apiSecurityAction `json:"data"`
+	}
+	if err := client.doJSONRequest(ctx, http.MethodGet, "security/actions", q, nil, &result); err != nil {
+		return diag.Errorf("failed to list Wazuh security actions: %v", err)
+	}
+
+	names := make([]string, 0, len(result.Data))
+	for name := range result.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	actions := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		a := result.Data[name]
+		actions = append(actions, map[string]interface{}{
+			"name":              name,
+			"description":       a.Description,
+			"resources":         a.Resources,
+			"related_endpoints": a.RelatedEndpoints,
+			"example":           string(a.Example),
+		})
+	}
+
+	d.SetId(dataSourceQueryID("security-actions", q))
+	_ = d.Set("names", names)
+	if err := d.Set("actions", actions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
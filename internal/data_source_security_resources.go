@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceSecurityResources lists the RBAC resource types usable in policies via:
+//   - GET /security/resources (Read)
+func dataSourceSecurityResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecurityResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only this resource type, e.g. \"agent:id\".",
+			},
+
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Sorted resource types.",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching resource types, sorted by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":        {Type: schema.TypeString, Computed: true, Description: "Resource type, e.g. \"agent:id\"."},
+						"description": {Type: schema.TypeString, Computed: true, Description: "Resource description."},
+						"example":     {Type: schema.TypeString, Computed: true, Description: "Example resource value, e.g. \"agent:id:001\"."},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecurityResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{"resource", "resource"},
+	})
+
+	var result struct {
+		Data map[string]struct {
+			Description string `json:"description"`
+			Example     string `json:"example"`
+		} `json:"data"`
+	}
+	if err := client.doJSONRequest(ctx, http.MethodGet, "security/resources", q, nil, &result); err != nil {
+		return diag.Errorf("failed to list Wazuh security resources: %v", err)
+	}
+
+	names := make([]string, 0, len(result.Data))
+	for name := range result.Data {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resources := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		r := result.Data[name]
+		resources = append(resources, map[string]interface{}{
+			"name":        name,
+			"description": r.Description,
+			"example":     r.Example,
+		})
+	}
+
+	d.SetId(dataSourceQueryID("security-resources", q))
+	_ = d.Set("names", names)
+	if err := d.Set("resources", resources); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
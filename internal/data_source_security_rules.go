@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// securityRuleObjects describes RBAC mapping rules for the wazuh_security_rule
+// and wazuh_security_rules data sources:
+//   - GET /security/rules?rule_ids=<id> (Read by rule_id)
+//   - GET /security/rules?search=<name> (Read by name / list, paged)
+var securityRuleObjects = securityObjectKind{
+	Label:     "security rule",
+	Plural:    "rules",
+	Path:      "security/rules",
+	IDsParam:  "rule_ids",
+	IDAttr:    "rule_id",
+	NameField: "name",
+
+	Attributes: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"id":   {Type: schema.TypeInt, Computed: true, Description: "Security rule ID."},
+			"name": {Type: schema.TypeString, Computed: true, Description: "Security rule name."},
+			"rule": {Type: schema.TypeString, Computed: true, Description: "Rule body (authorization context matcher) as JSON."},
+			"role_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the roles the rule is linked to.",
+			},
+		}
+	},
+
+	Flatten: func(raw json.RawMessage) (map[string]interface{}, error) {
+		var r struct {
+			ID    int             `json:"id"`
+			Name  string          `json:"name"`
+			Rule  json.RawMessage `json:"rule"`
+			Roles []int           `json:"roles"`
+		}
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"id":       r.ID,
+			"name":     r.Name,
+			"rule":     string(r.Rule),
+			"role_ids": intList(r.Roles),
+		}, nil
+	},
+}
+
+func dataSourceSecurityRule() *schema.Resource {
+	return securityObjectDataSource(securityRuleObjects)
+}
+
+func dataSourceSecurityRules() *schema.Resource {
+	return securityObjectsDataSource(securityRuleObjects)
+}
@@ -0,0 +1,427 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// syscollectorKind describes one syscollector inventory type. Every kind is
+// served by one data source reading either
+//   - GET /syscollector/{agent_id}/{Type}      (one agent), or
+//   - GET /experimental/syscollector/{Type}    (all or agents_list agents)
+type syscollectorKind struct {
+	Type     string // API path element, e.g. "packages"
+	ItemsKey string // list attribute holding the results, e.g. "packages"
+	Label    string // used in messages, e.g. "packages"
+
+	// SingleItem kinds (hardware, os) return one unpaged item per agent and
+	// accept no filters on the per-agent endpoint.
+	SingleItem bool
+
+	Filters []syscollectorAttr
+	Fields  []syscollectorAttr
+}
+
+// syscollectorAttr maps a Terraform attribute to a (dotted) API field.
+type syscollectorAttr struct {
+	Attr        string
+	Field       string
+	Type        schema.ValueType
+	Description string
+}
+
+func (k syscollectorKind) dataSource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"agent_id": {Type: schema.TypeString, Computed: true, Description: "ID of the agent the item belongs to."},
+	}
+	for _, f := range k.Fields {
+		fields[f.Attr] = &schema.Schema{Type: f.Type, Computed: true, Description: f.Description}
+	}
+
+	s := map[string]*schema.Schema{
+		// ---- Scope ----
+		"agent_id": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"agents_list"},
+			Description:   "Agent to read the inventory of. If not set, the experimental cross-agent endpoint is used.",
+		},
+		"agents_list": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Only these agents (experimental cross-agent endpoint). All agents if neither this nor agent_id is set.",
+		},
+
+		// ---- Filters ----
+		"search": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only items whose fields contain this string.",
+		},
+		"q": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Wazuh query language filter.",
+		},
+		"select": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Only request these API fields. Attributes of fields not selected are left empty.",
+		},
+		"limit": dataSourceLimitSchema(),
+
+		// ---- Results ----
+		k.ItemsKey: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Inventoried %s.", k.Label),
+			Elem:        &schema.Resource{Schema: fields},
+		},
+	}
+	for _, f := range k.Filters {
+		s[f.Attr] = &schema.Schema{Type: schema.TypeString, Optional: true, Description: f.Description}
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return k.read(ctx, d, meta)
+		},
+		Schema: s,
+	}
+}
+
+func (k syscollectorKind) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+	agentID := d.Get("agent_id").(string)
+
+	params := []queryParam{
+		{"agents_list", "agents_list"},
+		{"search", "search"},
+		{"q", "q"},
+		{"select", "select"},
+		{"limit", "limit"},
+	}
+	for _, f := range k.Filters {
+		params = append(params, queryParam{f.Attr, f.Field})
+	}
+	q := buildQuery(d, params)
+
+	var items []json.RawMessage
+	var err error
+	switch {
+	case agentID == "":
+		items, err = client.listAffectedItems(ctx, "experimental/syscollector/"+k.Type, q)
+	case k.SingleItem:
+		for param := range q {
+			if param != "select" {
+				return diag.Errorf("only select is supported together with agent_id for the %s inventory, remove %q", k.Label, param)
+			}
+		}
+		var result struct {
+			Data struct {
+				AffectedItems []json.RawMessage `json:"affected_items"`
+			} `json:"data"`
+		}
+		err = client.doJSONRequest(ctx, http.MethodGet, fmt.Sprintf("syscollector/%s/%s", url.PathEscape(agentID), k.Type), q, nil, &result)
+		items = result.Data.AffectedItems
+	default:
+		items, err = client.listAffectedItems(ctx, fmt.Sprintf("syscollector/%s/%s", url.PathEscape(agentID), k.Type), q)
+	}
+	if err != nil {
+		return diag.Errorf("failed to read Wazuh syscollector %s: %v", k.Label, err)
+	}
+
+	out := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var item map[string]interface{}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return diag.Errorf("failed to parse Wazuh syscollector %s: %v", k.Label, err)
+		}
+		m := map[string]interface{}{
+			"agent_id": scalarString(item["agent_id"]),
+		}
+		if agentID != "" {
+			m["agent_id"] = agentID
+		}
+		for _, f := range k.Fields {
+			m[f.Attr] = syscollectorValue(lookupField(item, f.Field), f.Type)
+		}
+		out = append(out, m)
+	}
+
+	idQuery := url.Values{"agent_id": {agentID}}
+	for p, v := range q {
+		idQuery[p] = v
+	}
+	d.SetId(dataSourceQueryID("syscollector-"+k.Type, idQuery))
+	if err := d.Set(k.ItemsKey, out); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// lookupField returns the value of a dotted field path such as "cpu.name".
+func lookupField(item map[string]interface{}, path string) interface{} {
+	var v interface{} = item
+	for _, part := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[part]
+	}
+	return v
+}
+
+// syscollectorValue converts an API value to the attribute type. Numbers are
+// sometimes reported as strings depending on the agent OS and version.
+func syscollectorValue(v interface{}, t schema.ValueType) interface{} {
+	s := scalarString(v)
+	switch t {
+	case schema.TypeInt:
+		n, _ := strconv.ParseFloat(s, 64)
+		return int(n)
+	case schema.TypeFloat:
+		n, _ := strconv.ParseFloat(s, 64)
+		return n
+	default:
+		return s
+	}
+}
+
+func strField(attr, field, desc string) syscollectorAttr {
+	return syscollectorAttr{Attr: attr, Field: field, Type: schema.TypeString, Description: desc}
+}
+
+func intField(attr, field, desc string) syscollectorAttr {
+	return syscollectorAttr{Attr: attr, Field: field, Type: schema.TypeInt, Description: desc}
+}
+
+var scanTimeField = strField("scan_time", "scan.time", "Time of the scan that reported the item.")
+
+var syscollectorHardware = syscollectorKind{
+	Type: "hardware", ItemsKey: "hardware", Label: "hardware", SingleItem: true,
+	Filters: []syscollectorAttr{
+		strField("cpu_name", "cpu.name", "Filter by CPU name (cross-agent only)."),
+		strField("cpu_cores", "cpu.cores", "Filter by number of CPU cores (cross-agent only)."),
+		strField("board_serial", "board_serial", "Filter by board serial (cross-agent only)."),
+	},
+	Fields: []syscollectorAttr{
+		strField("cpu_name", "cpu.name", "CPU name."),
+		intField("cpu_cores", "cpu.cores", "Number of CPU cores."),
+		{Attr: "cpu_mhz", Field: "cpu.mhz", Type: schema.TypeFloat, Description: "CPU frequency in MHz."},
+		intField("ram_total", "ram.total", "Total RAM in KB."),
+		intField("ram_free", "ram.free", "Free RAM in KB."),
+		intField("ram_usage", "ram.usage", "RAM usage in percent."),
+		strField("board_serial", "board_serial", "Motherboard serial number."),
+		scanTimeField,
+	},
+}
+
+var syscollectorOS = syscollectorKind{
+	Type: "os", ItemsKey: "os", Label: "operating system", SingleItem: true,
+	Filters: []syscollectorAttr{
+		strField("os_name", "os.name", "Filter by OS name (cross-agent only)."),
+		strField("os_version", "os.version", "Filter by OS version (cross-agent only)."),
+		strField("architecture", "architecture", "Filter by architecture (cross-agent only)."),
+		strField("release", "release", "Filter by kernel release (cross-agent only)."),
+	},
+	Fields: []syscollectorAttr{
+		strField("hostname", "hostname", "Host name."),
+		strField("architecture", "architecture", "Architecture."),
+		strField("os_name", "os.name", "OS name."),
+		strField("os_version", "os.version", "OS version."),
+		strField("os_platform", "os.platform", "OS platform."),
+		strField("os_major", "os.major", "OS major version."),
+		strField("os_minor", "os.minor", "OS minor version."),
+		strField("os_codename", "os.codename", "OS codename."),
+		strField("os_build", "os.build", "OS build (Windows)."),
+		strField("sysname", "sysname", "Kernel name."),
+		strField("release", "release", "Kernel release."),
+		strField("version", "version", "Kernel version."),
+		scanTimeField,
+	},
+}
+
+var syscollectorPackages = syscollectorKind{
+	Type: "packages", ItemsKey: "packages", Label: "packages",
+	Filters: []syscollectorAttr{
+		strField("name", "name", "Filter by package name."),
+		strField("version", "version", "Filter by package version."),
+		strField("vendor", "vendor", "Filter by vendor."),
+		strField("architecture", "architecture", "Filter by architecture."),
+		strField("format", "format", "Filter by package format, e.g. deb, rpm, win, pkg."),
+	},
+	Fields: []syscollectorAttr{
+		strField("name", "name", "Package name."),
+		strField("version", "version", "Package version."),
+		strField("vendor", "vendor", "Vendor."),
+		strField("architecture", "architecture", "Architecture."),
+		strField("format", "format", "Package format."),
+		strField("description", "description", "Package description."),
+		strField("location", "location", "Installation location."),
+		strField("source", "source", "Source package."),
+		strField("section", "section", "Package section."),
+		strField("priority", "priority", "Package priority."),
+		intField("size", "size", "Installed size."),
+		strField("install_time", "install_time", "Installation time."),
+		scanTimeField,
+	},
+}
+
+var syscollectorPorts = syscollectorKind{
+	Type: "ports", ItemsKey: "ports", Label: "ports",
+	Filters: []syscollectorAttr{
+		strField("protocol", "protocol", "Filter by protocol, e.g. tcp, udp6."),
+		strField("local_ip", "local.ip", "Filter by local IP."),
+		strField("local_port", "local.port", "Filter by local port."),
+		strField("remote_ip", "remote.ip", "Filter by remote IP."),
+		strField("state", "state", "Filter by state, e.g. listening."),
+		strField("pid", "pid", "Filter by process ID."),
+		strField("process", "process", "Filter by process name."),
+	},
+	Fields: []syscollectorAttr{
+		strField("protocol", "protocol", "Protocol."),
+		strField("local_ip", "local.ip", "Local IP."),
+		intField("local_port", "local.port", "Local port."),
+		strField("remote_ip", "remote.ip", "Remote IP."),
+		intField("remote_port", "remote.port", "Remote port."),
+		strField("state", "state", "Connection state."),
+		intField("pid", "pid", "Process ID."),
+		strField("process", "process", "Process name."),
+		intField("inode", "inode", "Socket inode."),
+		intField("tx_queue", "tx_queue", "Transmit queue length."),
+		intField("rx_queue", "rx_queue", "Receive queue length."),
+		scanTimeField,
+	},
+}
+
+var syscollectorProcesses = syscollectorKind{
+	Type: "processes", ItemsKey: "processes", Label: "processes",
+	Filters: []syscollectorAttr{
+		strField("name", "name", "Filter by process name."),
+		strField("pid", "pid", "Filter by process ID."),
+		strField("ppid", "ppid", "Filter by parent process ID."),
+		strField("state", "state", "Filter by process state."),
+		strField("euser", "euser", "Filter by effective user."),
+		strField("egroup", "egroup", "Filter by effective group."),
+	},
+	Fields: []syscollectorAttr{
+		strField("name", "name", "Process name."),
+		intField("pid", "pid", "Process ID."),
+		intField("ppid", "ppid", "Parent process ID."),
+		strField("cmd", "cmd", "Command."),
+		strField("argvs", "argvs", "Command arguments."),
+		strField("state", "state", "Process state."),
+		strField("euser", "euser", "Effective user."),
+		strField("ruser", "ruser", "Real user."),
+		strField("egroup", "egroup", "Effective group."),
+		intField("priority", "priority", "Priority."),
+		intField("nice", "nice", "Nice value."),
+		intField("vm_size", "vm_size", "Virtual memory size in KB."),
+		intField("resident", "resident", "Resident set size in pages."),
+		intField("start_time", "start_time", "Start time (epoch seconds)."),
+		scanTimeField,
+	},
+}
+
+var syscollectorNetaddr = syscollectorKind{
+	Type: "netaddr", ItemsKey: "addresses", Label: "network addresses",
+	Filters: []syscollectorAttr{
+		strField("iface", "iface", "Filter by interface name."),
+		strField("proto", "proto", "Filter by protocol (ipv4, ipv6)."),
+		strField("address", "address", "Filter by address."),
+		strField("netmask", "netmask", "Filter by netmask."),
+		strField("broadcast", "broadcast", "Filter by broadcast address."),
+	},
+	Fields: []syscollectorAttr{
+		strField("iface", "iface", "Interface name."),
+		strField("proto", "proto", "Protocol (ipv4, ipv6)."),
+		strField("address", "address", "Address."),
+		strField("netmask", "netmask", "Netmask."),
+		strField("broadcast", "broadcast", "Broadcast address."),
+		scanTimeField,
+	},
+}
+
+var syscollectorNetiface = syscollectorKind{
+	Type: "netiface", ItemsKey: "interfaces", Label: "network interfaces",
+	Filters: []syscollectorAttr{
+		strField("name", "name", "Filter by interface name."),
+		strField("adapter", "adapter", "Filter by adapter."),
+		strField("type", "type", "Filter by interface type, e.g. ethernet."),
+		strField("state", "state", "Filter by state (up, down)."),
+		strField("mtu", "mtu", "Filter by MTU."),
+	},
+	Fields: []syscollectorAttr{
+		strField("name", "name", "Interface name."),
+		strField("adapter", "adapter", "Adapter."),
+		strField("type", "type", "Interface type."),
+		strField("state", "state", "State (up, down)."),
+		intField("mtu", "mtu", "MTU."),
+		strField("mac", "mac", "MAC address."),
+		intField("tx_packets", "tx.packets", "Transmitted packets."),
+		intField("rx_packets", "rx.packets", "Received packets."),
+		intField("tx_bytes", "tx.bytes", "Transmitted bytes."),
+		intField("rx_bytes", "rx.bytes", "Received bytes."),
+		intField("tx_errors", "tx.errors", "Transmit errors."),
+		intField("rx_errors", "rx.errors", "Receive errors."),
+		intField("tx_dropped", "tx.dropped", "Dropped outgoing packets."),
+		intField("rx_dropped", "rx.dropped", "Dropped incoming packets."),
+		scanTimeField,
+	},
+}
+
+var syscollectorHotfixes = syscollectorKind{
+	Type: "hotfixes", ItemsKey: "hotfixes", Label: "hotfixes",
+	Filters: []syscollectorAttr{
+		strField("hotfix", "hotfix", "Filter by hotfix ID, e.g. KB5034441."),
+	},
+	Fields: []syscollectorAttr{
+		strField("hotfix", "hotfix", "Hotfix ID."),
+		scanTimeField,
+	},
+}
+
+func dataSourceSyscollectorHardware() *schema.Resource {
+	return syscollectorHardware.dataSource()
+}
+
+func dataSourceSyscollectorOS() *schema.Resource {
+	return syscollectorOS.dataSource()
+}
+
+func dataSourceSyscollectorPackages() *schema.Resource {
+	return syscollectorPackages.dataSource()
+}
+
+func dataSourceSyscollectorPorts() *schema.Resource {
+	return syscollectorPorts.dataSource()
+}
+
+func dataSourceSyscollectorProcesses() *schema.Resource {
+	return syscollectorProcesses.dataSource()
+}
+
+func dataSourceSyscollectorNetaddr() *schema.Resource {
+	return syscollectorNetaddr.dataSource()
+}
+
+func dataSourceSyscollectorNetiface() *schema.Resource {
+	return syscollectorNetiface.dataSource()
+}
+
+func dataSourceSyscollectorHotfixes() *schema.Resource {
+	return syscollectorHotfixes.dataSource()
+}
@@ -0,0 +1,135 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceTasks reads the state of asynchronous tasks (agent upgrades, ...) via:
+//   - GET /tasks/status (Read, paged)
+func dataSourceTasks() *schema.Resource {
+	str := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Computed: true, Description: desc}
+	}
+	filter := func(desc string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Optional: true, Description: desc}
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceTasksRead,
+
+		Schema: map[string]*schema.Schema{
+			// ---- Filters ----
+			"task_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Only these task IDs, e.g. from wazuh_agent_upgrade.affected_items[*].task_id.",
+			},
+			"agents_list": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only tasks of these agents.",
+			},
+			"command": filter("Filter by command, e.g. upgrade, upgrade_custom."),
+			"node":    filter("Filter by cluster node."),
+			"module":  filter("Filter by module, e.g. upgrade_module."),
+			"status":  filter("Filter by status, e.g. Pending, In progress, Updated, Error, Cancelled, Timeout, Legacy."),
+			"search":  filter("Only tasks whose fields contain this string."),
+			"q":       filter("Wazuh query language filter."),
+			"limit":   dataSourceLimitSchema(),
+
+			// ---- Results ----
+			"statuses": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Number of returned tasks per status.",
+			},
+			"tasks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Matching tasks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_id":          {Type: schema.TypeInt, Computed: true, Description: "Task ID."},
+						"agent_id":         str("Agent the task runs on."),
+						"node":             str("Cluster node handling the task."),
+						"module":           str("Module, e.g. upgrade_module."),
+						"command":          str("Command, e.g. upgrade."),
+						"status":           str("Task status."),
+						"error_message":    str("Error message of failed tasks."),
+						"create_time":      str("Creation time."),
+						"last_update_time": str("Time of the last status update."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// apiTask is an element of data.affected_items of GET /tasks/status.
+type apiTask struct {
+	TaskID         int    `json:"task_id"`
+	AgentID        string `json:"agent_id"`
+	Node           string `json:"node"`
+	Module         string `json:"module"`
+	Command        string `json:"command"`
+	Status         string `json:"status"`
+	ErrorMessage   string `json:"error_message"`
+	CreateTime     string `json:"create_time"`
+	LastUpdateTime string `json:"last_update_time"`
+}
+
+func dataSourceTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*APIClient)
+
+	q := buildQuery(d, []queryParam{
+		{"task_ids", "tasks_list"},
+		{"agents_list", "agents_list"},
+		{"command", "command"},
+		{"node", "node"},
+		{"module", "module"},
+		{"status", "status"},
+		{"search", "search"},
+		{"q", "q"},
+		{"limit", "limit"},
+	})
+
+	items, err := client.listAffectedItems(ctx, "tasks/status", q)
+	if err != nil {
+		return diag.Errorf("failed to list Wazuh tasks: %v", err)
+	}
+
+	statuses := map[string]int{}
+	tasks := make([]map[string]interface{}, 0, len(items))
+	for _, raw := range items {
+		var t apiTask
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return diag.Errorf("failed to parse Wazuh task: %v", err)
+		}
+		statuses[t.Status]++
+		tasks = append(tasks, map[string]interface{}{
+			"task_id":          t.TaskID,
+			"agent_id":         t.AgentID,
+			"node":             t.Node,
+			"module":           t.Module,
+			"command":          t.Command,
+			"status":           t.Status,
+			"error_message":    t.ErrorMessage,
+			"create_time":      t.CreateTime,
+			"last_update_time": t.LastUpdateTime,
+		})
+	}
+
+	d.SetId(dataSourceQueryID("tasks", q))
+	_ = d.Set("statuses", statuses)
+	if err := d.Set("tasks", tasks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// userObjects describes API users for the wazuh_user and wazuh_users data sources:
+//   - GET /security/users?user_ids=<id> (Read by user_id)
+//   - GET /security/users?search=<name> (Read by username / list, paged)
+var userObjects = securityObjectKind{
+	Label:     "user",
+	Plural:    "users",
+	Path:      "security/users",
+	IDsParam:  "user_ids",
+	IDAttr:    "user_id",
+	NameField: "username",
+
+	Attributes: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"id":           {Type: schema.TypeInt, Computed: true, Description: "User ID."},
+			"username":     {Type: schema.TypeString, Computed: true, Description: "User name."},
+			"allow_run_as": {Type: schema.TypeBool, Computed: true, Description: "Whether the user may authenticate with an authorization context (run_as)."},
+			"role_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "IDs of the roles assigned to the user.",
+			},
+		}
+	},
+
+	Flatten: func(raw json.RawMessage) (map[string]interface{}, error) {
+		var u struct {
+			ID         int    `json:"id"`
+			Username   string `json:"username"`
+			AllowRunAs bool   `json:"allow_run_as"`
+			Roles      []int  `json:"roles"`
+		}
+		if err := json.Unmarshal(raw, &u); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"id":           u.ID,
+			"username":     u.Username,
+			"allow_run_as": u.AllowRunAs,
+			"role_ids":     intList(u.Roles),
+		}, nil
+	},
+}
+
+func dataSourceUser() *schema.Resource {
+	return securityObjectDataSource(userObjects)
+}
+
+func dataSourceUsers() *schema.Resource {
+	return securityObjectsDataSource(userObjects)
+}
@@ -0,0 +1,498 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Drift statuses of a managed object.
+const (
+	driftInSync  = "in_sync"
+	driftChanged = "changed"
+	driftMissing = "missing"
+)
+
+// tfStateResource is one managed resource instance from a Terraform v4 state file.
+type tfStateResource struct {
+	Address    string
+	Type       string
+	Attributes map[string]interface{}
+}
+
+func (r tfStateResource) str(name string) string {
+	switch v := r.Attributes[name].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+func (r tfStateResource) strList(name string) []string {
+	raw, _ := r.Attributes[name].([]interface{})
+	out := make([]string, 0, len(raw))
+	for _, v := range raw {
+		out = append(out, fmt.Sprint(v))
+	}
+	return out
+}
+
+// readTerraformState returns the wazuh_* managed resource instances of a state file.
+func readTerraformState(path string) ([]tfStateResource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var state struct {
+		Version   int `json:"version"`
+		Resources []struct {
+			Module    string `json:"module"`
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Name      string `json:"name"`
+			Instances []struct {
+				IndexKey   interface{}            `json:"index_key"`
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse state file: %w", err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported state file version %d (expected 4)", state.Version)
+	}
+
+	var resources []tfStateResource
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, "wazuh_") {
+			continue
+		}
+		base := r.Type + "." + r.Name
+		if r.Module != "" {
+			base = r.Module + "." + base
+		}
+		for _, inst := range r.Instances {
+			addr := base
+			switch k := inst.IndexKey.(type) {
+			case string:
+				addr += fmt.Sprintf("[%q]", k)
+			case float64:
+				addr += fmt.Sprintf("[%d]", int(k))
+			}
+			resources = append(resources, tfStateResource{Address: addr, Type: r.Type, Attributes: inst.Attributes})
+		}
+	}
+	return resources, nil
+}
+
+type driftItem struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+type driftUnmanaged struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	ID   string `json:"id,omitempty"`
+}
+
+type driftReport struct {
+	GeneratedAt string           `json:"generated_at"`
+	Endpoint    string           `json:"endpoint"`
+	StateFile   string           `json:"state_file"`
+	Managed     []driftItem      `json:"managed"`
+	Unmanaged   []driftUnmanaged `json:"unmanaged"`
+	Skipped     []string         `json:"skipped,omitempty"`
+	Summary     struct {
+		Checked   int `json:"checked"`
+		InSync    int `json:"in_sync"`
+		Changed   int `json:"changed"`
+		Missing   int `json:"missing"`
+		Unmanaged int `json:"unmanaged"`
+	} `json:"summary"`
+}
+
+func (r *driftReport) hasDrift() bool {
+	return r.Summary.Changed > 0 || r.Summary.Missing > 0 || r.Summary.Unmanaged > 0
+}
+
+// driftChecker compares one state instance with the live API and returns its status.
+type driftChecker func(ctx context.Context, c *APIClient, r tfStateResource) (status, detail string, err error)
+
+// driftCheckers covers every resource type that represents persistent server state.
+// Action-style resources (restarts, scans, logtest, ...) have nothing to drift from.
+var driftCheckers = map[string]driftChecker{
+	"wazuh_rule":                  driftRawFile(snapshotKindRuleFile, "filename", "content"),
+	"wazuh_decoder":               driftRawFile(snapshotKindDecoderFile, "filename", "content"),
+	"wazuh_cdb_list":              driftRawFile(snapshotKindCDBList, "filename", "content"),
+	"wazuh_manager_configuration": driftRawFile(snapshotKindManagerConfiguration, "", "configuration_xml"),
+	"wazuh_node_configuration":    driftRawFile(snapshotKindNodeConfiguration, "node_id", "configuration_xml"),
+	"wazuh_group_configuration":   driftGroupConfiguration,
+	"wazuh_group":                 driftGroup,
+	"wazuh_user":                  driftSecurityObject("security/users", "user_ids", "user_id", "username", ""),
+	"wazuh_role":                  driftSecurityObject("security/roles", "role_ids", "role_id", "name", ""),
+	"wazuh_policy":                driftSecurityObject("security/policies", "policy_ids", "policy_id", "name", "policy"),
+	"wazuh_security_rule":         driftSecurityObject("security/rules", "rule_ids", "rule_id", "name", "rule"),
+	"wazuh_role_user":             driftSecurityLink("security/users", "user_ids", "user_id", "roles", "role_ids"),
+	"wazuh_policy_role":           driftSecurityLink("security/roles", "role_ids", "role_id", "policies", "policy_ids"),
+	"wazuh_security_rule_role":    driftSecurityLink("security/roles", "role_ids", "role_id", "rules", "rule_ids"),
+	"wazuh_security_config":       driftSecurityConfig,
+	"wazuh_agent":                 driftAgent,
+}
+
+// driftRawFile compares a raw file attribute with the file currently served by the API.
+func driftRawFile(kind, nameAttr, contentAttr string) driftChecker {
+	return func(ctx context.Context, c *APIClient, r tfStateResource) (string, string, error) {
+		f := snapshotFile{Kind: kind, RelativeDirname: r.str("relative_dirname")}
+		if nameAttr != "" {
+			f.Name = r.str(nameAttr)
+		}
+		current, err := fetchSnapshotFile(ctx, c, f)
+		if isNotFound(err) {
+			return driftMissing, "file not found on the server", nil
+		}
+		if err != nil {
+			return "", "", err
+		}
+		if !sameContent(current, []byte(r.str(contentAttr))) {
+			return driftChanged, contentAttr + " differs from the server", nil
+		}
+		return driftInSync, "", nil
+	}
+}
+
+// driftGroupConfiguration mirrors resourceGroupConfigurationRead (GET /groups/{group_id}/configuration).
+func driftGroupConfiguration(ctx context.Context, c *APIClient, r tfStateResource) (string, string, error) {
+	groupID := r.str("group_id")
+	current, err := c.doRawRequest(ctx, http.MethodGet, "groups/"+url.PathEscape(groupID)+"/configuration", nil, nil, "")
+	if isNotFound(err) {
+		return driftMissing, "group not found on the server", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	if !sameContent(current, []byte(r.str("configuration_xml"))) {
+		return driftChanged, "configuration_xml differs from the server", nil
+	}
+	return driftInSync, "", nil
+}
+
+func driftGroup(ctx context.Context, c *APIClient, r tfStateResource) (string, string, error) {
+	exists, err := groupExists(ctx, c, r.str("group_id"))
+	if err != nil {
+		return "", "", err
+	}
+	if !exists {
+		return driftMissing, "group not found on the server", nil
+	}
+	return driftInSync, "", nil
+}
+
+// lookupSecurityItem fetches a single RBAC object by ID, returning nil when it does not exist.
+func lookupSecurityItem(ctx context.Context, c *APIClient, endpoint, idsParam, id string) (map[string]interface{}, error) {
+	q := url.Values{}
+	q.Set(idsParam, id)
+
+	var result struct {
+		Data struct {
+			AffectedItems []map[string]interface{} `json:"affected_items"`
+		} `json:"data"`
+	}
+	// Unknown IDs are reported as failed items (error=1) rather than an HTTP error.
+	body, err := c.doRawRequest(ctx, http.MethodGet, endpoint, q, nil, "")
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Data.AffectedItems) == 0 {
+		return nil, nil
+	}
+	return result.Data.AffectedItems[0], nil
+}
+
+// driftSecurityObject checks a user/role/policy/security rule by ID and compares
+// its name and, optionally, its JSON body attribute.
+func driftSecurityObject(endpoint, idsParam, idAttr, nameAttr, jsonAttr string) driftChecker {
+	return func(ctx context.Context, c *APIClient, r tfStateResource) (string, string, error) {
+		id := r.str(idAttr)
+		if id == "" {
+			id = r.str("id")
+		}
+		item, err := lookupSecurityItem(ctx, c, endpoint, idsParam, id)
+		if err != nil {
+			return "", "", err
+		}
+		if item == nil {
+			return driftMissing, fmt.Sprintf("%s %s not found on the server", idAttr, id), nil
+		}
+
+		var changes []string
+		if name := fmt.Sprint(item[nameAttr]); name != r.str(nameAttr) {
+			changes = append(changes, fmt.Sprintf("%s is %q on the server", nameAttr, name))
+		}
+		if jsonAttr != "" {
+			var want interface{}
+			if err := json.Unmarshal([]byte(r.str(jsonAttr)), &want); err == nil && !reflect.DeepEqual(want, item[jsonAttr]) {
+				changes = append(changes, jsonAttr+" differs from the server")
+			}
+		}
+		if len(changes) > 0 {
+			return driftChanged, strings.Join(changes, "; "), nil
+		}
+		return driftInSync, "", nil
+	}
+}
+
+// driftSecurityLink checks that an RBAC object still lists all linked IDs from state.
+func driftSecurityLink(endpoint, idsParam, idAttr, linkField, linkAttr string) driftChecker {
+	return func(ctx context.Context, c *APIClient, r tfStateResource) (string, string, error) {
+		id := r.str(idAttr)
+		item, err := lookupSecurityItem(ctx, c, endpoint, idsParam, id)
+		if err != nil {
+			return "", "", err
+		}
+		if item == nil {
+			return driftMissing, fmt.Sprintf("%s %s not found on the server", idAttr, id), nil
+		}
+
+		linked := map[string]bool{}
+		if raw, ok := item[linkField].([]interface{}); ok {
+			for _, v := range raw {
+				linked[fmt.Sprint(v)] = true
+			}
+		}
+		var missing []string
+		for _, want := range r.strList(linkAttr) {
+			if !linked[want] {
+				missing = append(missing, want)
+			}
+		}
+		if len(missing) > 0 {
+			return driftChanged, fmt.Sprintf("%s %s no longer linked", linkField, strings.Join(missing, ",")), nil
+		}
+		return driftInSync, "", nil
+	}
+}
+
+func driftSecurityConfig(ctx context.Context, c *APIClient, r tfStateResource) (string, string, error) {
+	var result struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := c.doJSONRequest(ctx, http.MethodGet, "security/config", nil, nil, &result); err != nil {
+		return "", "", err
+	}
+
+	var changes []string
+	for _, attr := range []string{"auth_token_exp_timeout", "rbac_mode"} {
+		if _, set := r.Attributes[attr]; !set || r.str(attr) == "" {
+			continue
+		}
+		live := fmt.Sprint(result.Data[attr])
+		if f, ok := result.Data[attr].(float64); ok {
+			live = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+		if live != r.str(attr) {
+			changes = append(changes, fmt.Sprintf("%s is %q on the server", attr, live))
+		}
+	}
+	if len(changes) > 0 {
+		return driftChanged, strings.Join(changes, "; "), nil
+	}
+	return driftInSync, "", nil
+}
+
+func driftAgent(ctx context.Context, c *APIClient, r tfStateResource) (string, string, error) {
+	id := r.str("agent_id")
+	if id == "" {
+		id = r.str("id")
+	}
+	q := url.Values{}
+	q.Set("agents_list", id)
+
+	var result struct {
+		Data struct {
+			AffectedItems []struct {
+				Name string `json:"name"`
+			} `json:"affected_items"`
+		} `json:"data"`
+	}
+	body, err := c.doRawRequest(ctx, http.MethodGet, "agents", q, nil, "")
+	if err != nil && !isNotFound(err) {
+		return "", "", err
+	}
+	if err == nil {
+		if err := json.Unmarshal(body, &result); err != nil {
+			return "", "", err
+		}
+	}
+	if len(result.Data.AffectedItems) == 0 {
+		return driftMissing, fmt.Sprintf("agent %s not found on the server", id), nil
+	}
+	if name := result.Data.AffectedItems[0].Name; name != r.str("name") {
+		return driftChanged, fmt.Sprintf("name is %q on the server", name), nil
+	}
+	return driftInSync, "", nil
+}
+
+// stockCDBLists are the CDB lists shipped with the manager. Unlike stock rules
+// and decoders (ruleset/...) they live next to custom lists in etc/lists, so
+// they are recognised by relative_dirname/filename.
+var stockCDBLists = map[string]bool{
+	"etc/lists/audit-keys":                      true,
+	"etc/lists/security-eventchannel":           true,
+	"etc/lists/amazon/aws-eventnames":           true,
+	"etc/lists/amazon/aws-sources":              true,
+	"etc/lists/malicious-ioc/malicious-ip":      true,
+	"etc/lists/malicious-ioc/malicious-domains": true,
+	"etc/lists/malicious-ioc/malware-hashes":    true,
+}
+
+// findUnmanaged lists custom objects on the server that no state instance manages.
+func findUnmanaged(ctx context.Context, c *APIClient, resources []tfStateResource) ([]driftUnmanaged, error) {
+	managed := map[string]bool{}
+	mark := func(kind, key string) {
+		if key != "" {
+			managed[kind+"/"+key] = true
+		}
+	}
+	// Ruleset files are keyed by relative_dirname/filename so a custom file
+	// is never matched against a stock file with the same name.
+	markFile := func(kind, dir, filename string) {
+		if filename != "" {
+			mark(kind, dir+"/"+filename)
+		}
+	}
+	dirOr := func(r tfStateResource, def string) string {
+		if dir := strings.Trim(r.str("relative_dirname"), "/"); dir != "" {
+			return dir
+		}
+		return def
+	}
+	for _, r := range resources {
+		switch r.Type {
+		case "wazuh_rule":
+			markFile(snapshotKindRuleFile, dirOr(r, "etc/rules"), r.str("filename"))
+		case "wazuh_decoder":
+			markFile(snapshotKindDecoderFile, dirOr(r, "etc/decoders"), r.str("filename"))
+		case "wazuh_cdb_list":
+			markFile(snapshotKindCDBList, "etc/lists", r.str("filename"))
+		case "wazuh_group", "wazuh_group_configuration":
+			mark("group", r.str("group_id"))
+		case "wazuh_user":
+			mark("user", r.str("user_id"))
+		case "wazuh_role":
+			mark("role", r.str("role_id"))
+		case "wazuh_policy":
+			mark("policy", r.str("policy_id"))
+		case "wazuh_security_rule":
+			mark("security_rule", r.str("rule_id"))
+		}
+	}
+
+	var unmanaged []driftUnmanaged
+
+	for _, src := range []struct {
+		kind     string
+		endpoint string
+		query    url.Values
+	}{
+		{snapshotKindRuleFile, "rules/files", url.Values{"relative_dirname": {"etc/rules"}}},
+		{snapshotKindDecoderFile, "decoders/files", url.Values{"relative_dirname": {"etc/decoders"}}},
+		{snapshotKindCDBList, "lists/files", nil},
+	} {
+		items, err := c.listAffectedItems(ctx, src.endpoint, src.query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", src.endpoint, err)
+		}
+		for _, raw := range items {
+			var f struct {
+				Filename        string `json:"filename"`
+				RelativeDirname string `json:"relative_dirname"`
+			}
+			if err := json.Unmarshal(raw, &f); err != nil {
+				return nil, err
+			}
+			name := f.RelativeDirname + "/" + f.Filename
+			if src.kind == snapshotKindCDBList && stockCDBLists[name] {
+				continue
+			}
+			if !managed[src.kind+"/"+name] {
+				unmanaged = append(unmanaged, driftUnmanaged{Kind: src.kind, Name: name})
+			}
+		}
+	}
+
+	groups, err := c.listAffectedItems(ctx, "groups", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %w", err)
+	}
+	for _, raw := range groups {
+		var g struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &g); err != nil {
+			return nil, err
+		}
+		if g.Name != "default" && !managed["group/"+g.Name] {
+			unmanaged = append(unmanaged, driftUnmanaged{Kind: "group", Name: g.Name})
+		}
+	}
+
+	for _, src := range []struct {
+		kind      string
+		endpoint  string
+		nameField string
+	}{
+		{"user", "security/users", "username"},
+		{"role", "security/roles", "name"},
+		{"policy", "security/policies", "name"},
+		{"security_rule", "security/rules", "name"},
+	} {
+		items, err := c.listAffectedItems(ctx, src.endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", src.endpoint, err)
+		}
+		for _, raw := range items {
+			var item map[string]interface{}
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, err
+			}
+			idFloat, _ := item["id"].(float64)
+			id := strconv.Itoa(int(idFloat))
+			// IDs below 100 are Wazuh defaults (administrator, readonly, wazuh-wui, ...).
+			if int(idFloat) < rbacReservedIDs || managed[src.kind+"/"+id] {
+				continue
+			}
+			unmanaged = append(unmanaged, driftUnmanaged{Kind: src.kind, Name: fmt.Sprint(item[src.nameField]), ID: id})
+		}
+	}
+
+	sort.SliceStable(unmanaged, func(i, j int) bool {
+		if unmanaged[i].Kind != unmanaged[j].Kind {
+			return unmanaged[i].Kind < unmanaged[j].Kind
+		}
+		return unmanaged[i].Name < unmanaged[j].Name
+	})
+	return unmanaged, nil
+}
@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &agentKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &agentKeyEphemeralResource{}
+)
+
+// agentKeyEphemeralResource reads an agent registration key without storing it in state.
+//
+// Endpoints:
+//   - GET /agents/{agent_id}/key (Open)
+type agentKeyEphemeralResource struct {
+	client *APIClient
+}
+
+type agentKeyEphemeralResourceModel struct {
+	AgentID types.String `tfsdk:"agent_id"`
+	Key     types.String `tfsdk:"key"`
+}
+
+func newAgentKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &agentKeyEphemeralResource{}
+}
+
+func (r *agentKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_agent_key"
+}
+
+func (r *agentKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the registration key of a Wazuh agent without persisting it in state.",
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Agent ID (e.g. 001).",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded agent key, as imported on the agent with `manage_agents -i`.",
+			},
+		},
+	}
+}
+
+func (r *agentKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *APIClient, got %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *agentKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// The provider configuration may still be unknown during plan; Open is
+	// called again once it is known.
+	if r.client == nil {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &ephemeral.Deferred{Reason: ephemeral.DeferredReasonProviderConfigUnknown}
+		}
+		return
+	}
+
+	var data agentKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	agentID := data.AgentID.ValueString()
+	var result struct {
+		Data struct {
+			AffectedItems []struct {
+				ID  string `json:"id"`
+				Key string `json:"key"`
+			} `json:"affected_items"`
+		} `json:"data"`
+	}
+	path := fmt.Sprintf("agents/%s/key", url.PathEscape(agentID))
+	if err := r.client.doJSONRequest(ctx, http.MethodGet, path, nil, nil, &result); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read key of agent '%s'", agentID), err.Error())
+		return
+	}
+	if len(result.Data.AffectedItems) == 0 || result.Data.AffectedItems[0].Key == "" {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to read key of agent '%s'", agentID), "agent not found")
+		return
+	}
+
+	data.Key = types.StringValue(result.Data.AffectedItems[0].Key)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
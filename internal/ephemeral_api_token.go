@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                     = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &apiTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &apiTokenEphemeralResource{}
+)
+
+// apiTokenEphemeralResource issues a Wazuh API JWT that is never stored in state.
+//
+// Endpoints:
+//   - POST /security/user/authenticate (Open)
+//   - POST /security/user/authenticate/run_as (Open, when auth_context is set)
+type apiTokenEphemeralResource struct {
+	client *APIClient
+}
+
+type apiTokenEphemeralResourceModel struct {
+	User        types.String `tfsdk:"user"`
+	Password    types.String `tfsdk:"password"`
+	AuthContext types.String `tfsdk:"auth_context"`
+	Token       types.String `tfsdk:"token"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func newAPITokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiTokenEphemeralResource{}
+}
+
+func (r *apiTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (r *apiTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issues a short-lived Wazuh API JWT without persisting it in state.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Optional:    true,
+				Description: "API user to authenticate as. Defaults to the provider user.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of user. Must be set together with user.",
+			},
+			"auth_context": schema.StringAttribute{
+				Optional:    true,
+				Description: "JSON authorization context. When set, the token is obtained via run_as (the user must have allow_run_as enabled).",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "JWT to send as `Authorization: Bearer <token>`.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the token (RFC 3339), taken from the JWT exp claim.",
+			},
+		},
+	}
+}
+
+func (r *apiTokenEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.RequiredTogether(path.MatchRoot("user"), path.MatchRoot("password")),
+	}
+}
+
+func (r *apiTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*APIClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *APIClient, got %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+func (r *apiTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	// The provider configuration may still be unknown during plan; Open is
+	// called again once it is known.
+	if r.client == nil {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &ephemeral.Deferred{Reason: ephemeral.DeferredReasonProviderConfigUnknown}
+		}
+		return
+	}
+
+	var data apiTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// user and password are validated to be set together. The provider
+	// credentials are not echoed back into the result.
+	user, password := r.client.User, r.client.Password
+	if !data.User.IsNull() {
+		user, password = data.User.ValueString(), data.Password.ValueString()
+	}
+
+	var authContext []byte
+	if !data.AuthContext.IsNull() {
+		authContext = []byte(data.AuthContext.ValueString())
+		if !json.Valid(authContext) {
+			resp.Diagnostics.AddError("Invalid auth_context", "auth_context must be valid JSON.")
+			return
+		}
+	}
+
+	token, err := r.client.requestToken(ctx, user, password, authContext)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Failed to obtain API token for user '%s'", user), err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token)
+	data.ExpiresAt = types.StringNull()
+	if exp, ok := jwtExpiry(token); ok {
+		data.ExpiresAt = types.StringValue(exp.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// jwtExpiry returns the exp claim of a JWT without verifying its signature.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+// frameworkProvider is served next to the SDKv2 Provider() through
+// terraform-plugin-mux. It hosts provider-defined functions, ephemeral
+// resources and everything else that needs the plugin framework.
+type frameworkProvider struct {
+	version string
+}
+
+type frameworkProviderModel struct {
+	Endpoint      types.String `tfsdk:"endpoint"`
+	User          types.String `tfsdk:"user"`
+	Password      types.String `tfsdk:"password"`
+	SkipSSLVerify types.Bool   `tfsdk:"skip_ssl_verify"`
+}
+
+// FrameworkProvider returns the plugin framework part of the provider.
+func FrameworkProvider(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &frameworkProvider{version: version}
+	}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "wazuh"
+	resp.Version = p.version
+}
+
+// Schema must stay identical to the SDKv2 provider schema, otherwise the mux
+// server refuses to start. The SDK reports attributes that are Required with
+// an environment DefaultFunc as Optional once the variable is set, so the
+// same is done here.
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Required:    os.Getenv("WAZUH_ENDPOINT") == "",
+				Optional:    os.Getenv("WAZUH_ENDPOINT") != "",
+				Description: "Full URL to Wazuh API endpoint (e.g. https://wazuh.example.com:55000).",
+			},
+			"user": schema.StringAttribute{
+				Required:    os.Getenv("WAZUH_USER") == "",
+				Optional:    os.Getenv("WAZUH_USER") != "",
+				Sensitive:   true,
+				Description: "Wazuh API username.",
+			},
+			"password": schema.StringAttribute{
+				Required:    os.Getenv("WAZUH_PASSWORD") == "",
+				Optional:    os.Getenv("WAZUH_PASSWORD") != "",
+				Sensitive:   true,
+				Description: "Wazuh API password.",
+			},
+			"skip_ssl_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip SSL certificate verification.",
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values may still be unknown during plan (e.g. coming from another resource).
+	if config.Endpoint.IsUnknown() || config.User.IsUnknown() || config.Password.IsUnknown() || config.SkipSSLVerify.IsUnknown() {
+		return
+	}
+
+	endpoint := stringValueOrEnv(config.Endpoint, "WAZUH_ENDPOINT")
+	user := stringValueOrEnv(config.User, "WAZUH_USER")
+	password := stringValueOrEnv(config.Password, "WAZUH_PASSWORD")
+	skipSSL := config.SkipSSLVerify.ValueBool()
+	if config.SkipSSLVerify.IsNull() {
+		skipSSL, _ = strconv.ParseBool(os.Getenv("WAZUH_SKIP_SSL_VERIFY"))
+	}
+
+	client, err := sharedAPIClient(endpoint, user, password, skipSSL)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to configure Wazuh API client", err.Error())
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+}
+
+func stringValueOrEnv(v types.String, env string) string {
+	if v.IsNull() {
+		return os.Getenv(env)
+	}
+	return v.ValueString()
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newAPITokenEphemeralResource,
+		newAgentKeyEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newXMLCanonicalizeFunction,
+		newParseCDBFunction,
+		newRuleIDsFunction,
+	}
+}
@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseCDBFunction{}
+
+// parseCDBFunction implements provider::wazuh::parse_cdb, turning the content
+// of a CDB list (as used by wazuh_cdb_list) into a map.
+type parseCDBFunction struct{}
+
+func newParseCDBFunction() function.Function {
+	return &parseCDBFunction{}
+}
+
+func (f *parseCDBFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_cdb"
+}
+
+func (f *parseCDBFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a CDB list into a map",
+		MarkdownDescription: "Parses `key:value` lines of a Wazuh CDB list into a map of strings. " +
+			"Keys may be quoted (`\"2001:db8::1\":bad`) to contain colons, keys without a value map to an empty string " +
+			"and blank lines are ignored. For a duplicated key the first value wins, as in the list the manager compiles.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "content",
+				Description: "CDB list content.",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *parseCDBFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	// Functions cannot return warnings, so duplicates are resolved silently
+	// the same way wazuh_cdb_list resolves them.
+	entries, _, err := parseCDBList(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, entries))
+}
+
+// parseCDBList parses CDB list content. The manager compiles every line into
+// the .cdb file and a CDB lookup returns the first record stored for a key, so
+// the first occurrence of a duplicated key wins; later ones are described in
+// duplicates.
+func parseCDBList(content string) (entries map[string]string, duplicates []string, err error) {
+	entries = map[string]string{}
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var key, value string
+		if strings.HasPrefix(line, `"`) {
+			end := strings.Index(line[1:], `"`)
+			if end < 0 {
+				return nil, nil, fmt.Errorf("line %d: unterminated quoted key", i+1)
+			}
+			key = line[1 : end+1]
+			rest := line[end+2:]
+			if rest != "" && !strings.HasPrefix(rest, ":") {
+				return nil, nil, fmt.Errorf("line %d: expected ':' after quoted key", i+1)
+			}
+			value = strings.TrimPrefix(rest, ":")
+		} else {
+			key, value, _ = strings.Cut(line, ":")
+		}
+
+		if key == "" {
+			return nil, nil, fmt.Errorf("line %d: empty key", i+1)
+		}
+		if _, ok := entries[key]; ok {
+			duplicates = append(duplicates, fmt.Sprintf("line %d: duplicate key %q ignored", i+1, key))
+			continue
+		}
+		entries[key] = value
+	}
+	return entries, duplicates, nil
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestParseCDBFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::parse_cdb("1.2.3.4:bad\n\"2001:db8::1\":bad\n\n10.0.0.1\r\n")
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"1.2.3.4":     knownvalue.StringExact("bad"),
+						"2001:db8::1": knownvalue.StringExact("bad"),
+						"10.0.0.1":    knownvalue.StringExact(""),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::parse_cdb("")
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::parse_cdb("\"2001:db8::1:bad")
+}`,
+				ExpectError: regexp.MustCompile(`unterminated quoted key`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::parse_cdb("a:1\nb:2\na:3")
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"a": knownvalue.StringExact("1"),
+						"b": knownvalue.StringExact("2"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestParseCDBListDuplicateKeys(t *testing.T) {
+	entries, duplicates, err := parseCDBList("a:1\n\"b\":2\na:3\nb:4\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries["a"] != "1" || entries["b"] != "2" || len(entries) != 2 {
+		t.Errorf("entries = %v, want the first value of each key", entries)
+	}
+	want := []string{`line 3: duplicate key "a" ignored`, `line 4: duplicate key "b" ignored`}
+	if strings.Join(duplicates, "|") != strings.Join(want, "|") {
+		t.Errorf("duplicates = %q, want %q", duplicates, want)
+	}
+}
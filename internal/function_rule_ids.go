@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ruleIDsFunction{}
+
+// ruleIDsFunction implements provider::wazuh::rule_ids, listing the IDs of the
+// rules defined in a rule file (e.g. the content of a wazuh_rule resource).
+type ruleIDsFunction struct{}
+
+func newRuleIDsFunction() function.Function {
+	return &ruleIDsFunction{}
+}
+
+func (f *ruleIDsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rule_ids"
+}
+
+func (f *ruleIDsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "List rule IDs defined in rule XML",
+		MarkdownDescription: "Returns the `id` of every `<rule>` inside the top-level `<group>` elements of a Wazuh rule file, " +
+			"in document order. `<var>` references are expanded.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "xml",
+				Description: "Rule file content.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.Int64Type,
+		},
+	}
+}
+
+func (f *ruleIDsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	ids, err := ruleIDs(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ids))
+}
+
+func ruleIDs(data string) ([]int64, error) {
+	nodes, err := parseXMLNodes([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid XML: %w", err)
+	}
+
+	f := &lintFile{Nodes: nodes, Vars: collectVars(nodes)}
+	ids := []int64{}
+	var badID error
+	forEachRule(f, func(rule *xmlNode, groups []string) {
+		if badID != nil {
+			return
+		}
+		raw := f.expand(rule.attr("id"))
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			badID = fmt.Errorf("line %d: rule id %q is not an integer", rule.Line, raw)
+			return
+		}
+		ids = append(ids, id)
+	})
+	if badID != nil {
+		return nil, badID
+	}
+	return ids, nil
+}
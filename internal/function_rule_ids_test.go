@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestRuleIDsFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::rule_ids(<<-EOT
+    <var name="BASE_ID">100010</var>
+    <group name="local,">
+      <!-- <rule id="100000" level="3"/> is commented out -->
+      <rule id="100001" level="3"><description>a</description></rule>
+      <rule id="$BASE_ID" level="3"><description>b</description></rule>
+    </group>
+    <group name="other,">
+      <rule id="100002" level="3"><description>c</description></rule>
+    </group>
+  EOT
+  )
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.Int64Exact(100001),
+						knownvalue.Int64Exact(100010),
+						knownvalue.Int64Exact(100002),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::rule_ids("")
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::rule_ids("<group name=\"local,\"><rule id=\"abc\" level=\"3\"/></group>")
+}`,
+				ExpectError: regexp.MustCompile(`rule id "abc" is not an integer`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::rule_ids("<group><rule id=\"1\"></group>")
+}`,
+				ExpectError: regexp.MustCompile(`invalid XML`),
+			},
+		},
+	})
+}
@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &xmlCanonicalizeFunction{}
+
+// xmlCanonicalizeFunction implements provider::wazuh::xml_canonicalize.
+// It normalises rule, decoder and ossec.conf style XML so that formatting-only
+// changes (indentation, attribute order, comments) do not show up as diffs.
+type xmlCanonicalizeFunction struct{}
+
+func newXMLCanonicalizeFunction() function.Function {
+	return &xmlCanonicalizeFunction{}
+}
+
+func (f *xmlCanonicalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "xml_canonicalize"
+}
+
+func (f *xmlCanonicalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalise Wazuh XML",
+		MarkdownDescription: "Returns the XML with comments and processing instructions removed, attributes sorted by name, " +
+			"text trimmed and elements indented with two spaces. Several root elements (as in rule and decoder files) are allowed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "xml",
+				Description: "XML document or fragment to canonicalize.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *xmlCanonicalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	out, err := canonicalizeXML(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "invalid XML: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, out))
+}
+
+func canonicalizeXML(data string) (string, error) {
+	nodes, err := parseXMLNodes([]byte(data))
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, n := range nodes {
+		writeCanonicalXMLNode(&b, n, 0)
+	}
+	return b.String(), nil
+}
+
+func writeCanonicalXMLNode(b *strings.Builder, n *xmlNode, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	b.WriteString(indent + "<" + n.Name)
+	names := make([]string, 0, len(n.Attrs))
+	for name := range n.Attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		b.WriteString(" " + name + `="` + xmlAttrEscaper.Replace(n.Attrs[name]) + `"`)
+	}
+
+	text := strings.TrimSpace(n.Text)
+	switch {
+	case len(n.Children) == 0 && text == "":
+		b.WriteString("/>\n")
+	case len(n.Children) == 0:
+		b.WriteString(">" + xmlTextEscaper.Replace(text) + "</" + n.Name + ">\n")
+	default:
+		b.WriteString(">\n")
+		if text != "" {
+			b.WriteString(indent + "  " + xmlTextEscaper.Replace(text) + "\n")
+		}
+		for _, child := range n.Children {
+			writeCanonicalXMLNode(b, child, depth+1)
+		}
+		b.WriteString(indent + "</" + n.Name + ">\n")
+	}
+}
+
+var (
+	xmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	xmlAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;")
+)
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestXMLCanonicalizeFunction(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::xml_canonicalize(<<-EOT
+    <!-- Local rules -->
+    <group name="local,">
+      <rule level="5" id="100001">
+        <match>  a &amp; b  </match>
+        <!-- matched sshd -->
+      </rule>
+    </group>
+    <var name="X">1</var>
+  EOT
+  )
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(
+						"<group name=\"local,\">\n"+
+							"  <rule id=\"100001\" level=\"5\">\n"+
+							"    <match>a &amp; b</match>\n"+
+							"  </rule>\n"+
+							"</group>\n"+
+							"<var name=\"X\">1</var>\n")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::xml_canonicalize("")
+}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::wazuh::xml_canonicalize("<group><rule></group>")
+}`,
+				ExpectError: regexp.MustCompile(`invalid XML`),
+			},
+		},
+	})
+}
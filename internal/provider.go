@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -86,7 +87,55 @@ func Provider() *schema.Provider {
 			"wazuh_security_rule_role":    resourceSecurityRuleRole(),
 			"wazuh_security_config":       resourceSecurityConfig(),
 		},
-		DataSourcesMap:       map[string]*schema.Resource{},
+		DataSourcesMap: map[string]*schema.Resource{
+			"wazuh_agents":                 dataSourceAgents(),
+			"wazuh_agent":                  dataSourceAgent(),
+			"wazuh_agent_configuration":    dataSourceAgentConfiguration(),
+			"wazuh_agent_daemon_stats":     dataSourceAgentDaemonStats(),
+			"wazuh_group":                  dataSourceGroup(),
+			"wazuh_groups":                 dataSourceGroups(),
+			"wazuh_cluster_nodes":          dataSourceClusterNodes(),
+			"wazuh_cluster_health":         dataSourceClusterHealth(),
+			"wazuh_manager_info":           dataSourceManagerInfo(),
+			"wazuh_manager_status":         dataSourceManagerStatus(),
+			"wazuh_manager_api_config":     dataSourceManagerAPIConfig(),
+			"wazuh_manager_logs":           dataSourceManagerLogs(),
+			"wazuh_manager_logs_summary":   dataSourceManagerLogsSummary(),
+			"wazuh_manager_stats":          dataSourceManagerStats(),
+			"wazuh_manager_stats_hourly":   dataSourceManagerStatsHourly(),
+			"wazuh_manager_stats_weekly":   dataSourceManagerStatsWeekly(),
+			"wazuh_manager_daemon_stats":   dataSourceManagerDaemonStats(),
+			"wazuh_rules":                  dataSourceRules(),
+			"wazuh_decoders":               dataSourceDecoders(),
+			"wazuh_cdb_list":               dataSourceCDBList(),
+			"wazuh_security_actions":       dataSourceSecurityActions(),
+			"wazuh_security_resources":     dataSourceSecurityResources(),
+			"wazuh_user":                   dataSourceUser(),
+			"wazuh_users":                  dataSourceUsers(),
+			"wazuh_role":                   dataSourceRole(),
+			"wazuh_roles":                  dataSourceRoles(),
+			"wazuh_policy":                 dataSourcePolicy(),
+			"wazuh_policies":               dataSourcePolicies(),
+			"wazuh_security_rule":          dataSourceSecurityRule(),
+			"wazuh_security_rules":         dataSourceSecurityRules(),
+			"wazuh_syscollector_hardware":  dataSourceSyscollectorHardware(),
+			"wazuh_syscollector_os":        dataSourceSyscollectorOS(),
+			"wazuh_syscollector_packages":  dataSourceSyscollectorPackages(),
+			"wazuh_syscollector_ports":     dataSourceSyscollectorPorts(),
+			"wazuh_syscollector_processes": dataSourceSyscollectorProcesses(),
+			"wazuh_syscollector_netaddr":   dataSourceSyscollectorNetaddr(),
+			"wazuh_syscollector_netiface":  dataSourceSyscollectorNetiface(),
+			"wazuh_syscollector_hotfixes":  dataSourceSyscollectorHotfixes(),
+			"wazuh_sca_policies":           dataSourceSCAPolicies(),
+			"wazuh_sca_checks":             dataSourceSCAChecks(),
+			"wazuh_fim_files":              dataSourceFIMFiles(),
+			"wazuh_mitre_tactics":          dataSourceMitreTactics(),
+			"wazuh_mitre_techniques":       dataSourceMitreTechniques(),
+			"wazuh_mitre_groups":           dataSourceMitreGroups(),
+			"wazuh_mitre_mitigations":      dataSourceMitreMitigations(),
+			"wazuh_mitre_coverage":         dataSourceMitreCoverage(),
+			"wazuh_tasks":                  dataSourceTasks(),
+		},
 		ConfigureContextFunc: configureProvider,
 	}
 }
@@ -99,6 +148,49 @@ func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}
 	password := d.Get("password").(string)
 	skipSSL := d.Get("skip_ssl_verify").(bool)
 
+	client, err := sharedAPIClient(endpoint, user, password, skipSSL)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return client, diags
+}
+
+// apiClientConfig identifies a provider configuration in sharedAPIClient.
+type apiClientConfig struct {
+	endpoint string
+	user     string
+	password string
+	skipSSL  bool
+}
+
+var (
+	apiClientsMu sync.Mutex
+	apiClients   = map[apiClientConfig]*APIClient{}
+)
+
+// sharedAPIClient returns the API client for a provider configuration,
+// authenticating only once. The SDKv2 and framework providers are configured
+// separately by the mux server but talk to the same Wazuh API.
+func sharedAPIClient(endpoint, user, password string, skipSSL bool) (*APIClient, error) {
+	cfg := apiClientConfig{endpoint: endpoint, user: user, password: password, skipSSL: skipSSL}
+
+	apiClientsMu.Lock()
+	defer apiClientsMu.Unlock()
+
+	if client, ok := apiClients[cfg]; ok {
+		return client, nil
+	}
+	client, err := newAPIClient(endpoint, user, password, skipSSL)
+	if err != nil {
+		return nil, err
+	}
+	apiClients[cfg] = client
+	return client, nil
+}
+
+// newAPIClient builds an HTTP client for the given endpoint and obtains a JWT token.
+func newAPIClient(endpoint, user, password string, skipSSL bool) (*APIClient, error) {
 	transport := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: skipSSL,
@@ -118,20 +210,34 @@ func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	token, err := client.authenticate()
 	if err != nil {
-		return nil, diag.FromErr(err)
+		return nil, err
 	}
 	client.AuthToken = token
 
-	return client, diags
+	return client, nil
 }
 
 // Authenticate with basicAuth and obtain JWT token
 func (c *APIClient) authenticate() (string, error) {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/security/user/authenticate", c.Endpoint), nil)
+	return c.requestToken(context.Background(), c.User, c.Password, nil)
+}
+
+// requestToken obtains a JWT for the given credentials. A non-nil authContext
+// is sent to /security/user/authenticate/run_as, which requires the user to
+// have allow_run_as enabled.
+func (c *APIClient) requestToken(ctx context.Context, user, password string, authContext []byte) (string, error) {
+	path := "security/user/authenticate"
+	var reqBody io.Reader
+	if authContext != nil {
+		path = "security/user/authenticate/run_as"
+		reqBody = bytes.NewReader(authContext)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/%s", c.Endpoint, path), reqBody)
 	if err != nil {
 		return "", fmt.Errorf("failed to build auth request: %w", err)
 	}
-	req.SetBasicAuth(c.User, c.Password)
+	req.SetBasicAuth(user, password)
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := c.HTTPClient.Do(req)
@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// testProtoV5ProviderFactories serves the SDKv2 and framework providers
+// through the same mux server as main.go.
+var testProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"wazuh": func() (tfprotov5.ProviderServer, error) {
+		muxServer, err := tf5muxserver.NewMuxServer(context.Background(),
+			Provider().GRPCProvider,
+			providerserver.NewProtocol5(FrameworkProvider("test")()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return muxServer.ProviderServer(), nil
+	},
+}
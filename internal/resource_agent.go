@@ -59,12 +59,28 @@ func resourceAgent() *schema.Resource {
 
 			// Shared key; optional. If not provided, you typically register agents via other mechanisms.
 			"key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Sensitive:   true,
-				ForceNew:    true,
-				Description: "Shared key used for communication with the manager. If omitted, Wazuh may generate / manage it separately.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Sensitive:     true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key_wo"},
+				Description:   "Shared key used for communication with the manager. If omitted, Wazuh may generate / manage it separately.",
+			},
+			"key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"key"},
+				Description:   "Write-only shared key (Terraform 1.11+). Never stored in state; bump key_wo_version to re-insert the agent with a new key.",
+			},
+			"key_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"key_wo"},
+				Description:  "Version of key_wo. Changing it replaces the agent using the current key_wo value.",
 			},
 
 			// Whether to purge the agent from keystore on destroy
@@ -148,6 +164,15 @@ func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	agentID := strings.TrimSpace(d.Get("agent_id").(string))
 	ip := strings.TrimSpace(d.Get("ip").(string))
 	key := strings.TrimSpace(d.Get("key").(string))
+	writeOnlyKey := false
+	if key == "" {
+		wo, diags := writeOnlyString(d, "key_wo")
+		if diags.HasError() {
+			return diags
+		}
+		key = strings.TrimSpace(wo)
+		writeOnlyKey = key != ""
+	}
 
 	payload := make(map[string]interface{})
 	payload["name"] = name
@@ -227,7 +252,8 @@ func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	d.SetId(finalID)
 	_ = d.Set("agent_id", finalID)
 
-	if result.Data.Key != "" {
+	// A key passed through key_wo must not end up in state via the computed key attribute.
+	if result.Data.Key != "" && !writeOnlyKey {
 		_ = d.Set("key", result.Data.Key)
 	}
 
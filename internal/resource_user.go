@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,7 +18,7 @@ import (
 // resourceUser manages Wazuh API users via:
 //   - POST   /security/users         (Create)
 //   - GET    /security/users         (Read, list/filter by user_ids)
-//   - PUT    /security/users/{id}    (Update password)
+//   - PUT    /security/users/{id}    (Update password, or password_wo on password_wo_version change)
 //   - DELETE /security/users         (Delete by user_ids)
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
@@ -41,10 +42,25 @@ func resourceUser() *schema.Resource {
 				Description: "Wazuh API username (4–64 characters).",
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Password for the Wazuh API user. On update, changing this will rotate the user's password.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"password_wo"},
+				Description:   "Password for the Wazuh API user. On update, changing this will rotate the user's password.",
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				Description:   "Write-only password for the Wazuh API user (Terraform 1.11+). Never stored in state; bump password_wo_version to rotate it.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of password_wo. Changing it sends the current password_wo value to the API.",
 			},
 
 			// ---- Computed fields ----
@@ -65,6 +81,13 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	username := strings.TrimSpace(d.Get("username").(string))
 	password := d.Get("password").(string)
+	if password == "" {
+		wo, diags := writeOnlyString(d, "password_wo")
+		if diags.HasError() {
+			return diags
+		}
+		password = wo
+	}
 
 	if username == "" {
 		return diag.Errorf("username must not be empty")
@@ -214,8 +237,15 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("cannot update Wazuh user: missing ID")
 	}
 
-	if d.HasChange("password") {
+	if d.HasChanges("password", "password_wo_version") {
 		newPass := d.Get("password").(string)
+		if d.HasChange("password_wo_version") {
+			wo, diags := writeOnlyString(d, "password_wo")
+			if diags.HasError() {
+				return diags
+			}
+			newPass = wo
+		}
 		if newPass == "" {
 			return diag.Errorf("password cannot be empty when updating Wazuh user")
 		}
@@ -312,6 +342,20 @@ func resourceUserImport(ctx context.Context, d *schema.ResourceData, meta interf
 	return []*schema.ResourceData{d}, nil
 }
 
+// ----------------------
+// Helper: read a WriteOnly attribute, which is only available in the raw config
+// ----------------------
+func writeOnlyString(d *schema.ResourceData, name string) (string, diag.Diagnostics) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(name))
+	if diags.HasError() {
+		return "", diags
+	}
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return v.AsString(), nil
+}
+
 // ----------------------
 // Helper: find user_id by username via GET /security/users
 // ----------------------
@@ -0,0 +1,558 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// lintSeverityError is the SARIF level of every issue reported by the linter.
+const lintSeverityError = "error"
+
+// Default custom rule ID range reserved by Wazuh for user rules.
+const (
+	customRuleIDMin = 100000
+	customRuleIDMax = 120000
+)
+
+type lintCheck struct {
+	ID          string
+	Description string
+}
+
+// lintChecks lists every check reported by the ruleset linter.
+var lintChecks = []lintCheck{
+	{"xml-syntax", "File is not valid XML."},
+	{"invalid-rule-id", "Rule ID is missing or not an integer."},
+	{"duplicate-rule-id", "Rule ID is defined more than once without overwrite=\"yes\"."},
+	{"rule-id-range", "Rule ID is outside the custom rule range."},
+	{"dangling-if-sid", "if_sid references a rule that is not defined."},
+	{"dangling-if-matched-sid", "if_matched_sid references a rule that is not defined."},
+	{"dangling-if-group", "if_group/if_matched_group references a group that no rule belongs to."},
+	{"unknown-decoder", "decoded_as references a decoder that is not defined."},
+	{"unknown-decoder-parent", "Decoder parent is not defined."},
+	{"invalid-regex", "Pattern is not valid OS_Regex syntax."},
+	{"invalid-pcre2", "Pattern is not valid PCRE2 syntax."},
+	{"invalid-level", "Rule level must be an integer between 0 and 16."},
+	{"invalid-frequency", "Rule frequency must be an integer between 2 and 9999."},
+	{"invalid-timeframe", "Rule timeframe must be an integer between 1 and 99999."},
+	{"missing-timeframe", "Correlation rule (frequency/if_matched_*) is missing frequency or timeframe."},
+	{"invalid-mitre-id", "MITRE ATT&CK technique ID is malformed (expected Txxxx or Txxxx.yyy)."},
+}
+
+type lintIssue struct {
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Check    string `json:"check"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// xmlNode is a minimal DOM used by the linter; Wazuh ruleset files have several
+// root elements and are not single-document XML.
+type xmlNode struct {
+	Name     string
+	Attrs    map[string]string
+	Text     string
+	Line     int
+	Children []*xmlNode
+}
+
+func (n *xmlNode) attr(name string) string {
+	return strings.TrimSpace(n.Attrs[name])
+}
+
+func parseXMLNodes(data []byte) ([]*xmlNode, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.Entity = xml.HTMLEntity
+
+	root := &xmlNode{}
+	stack := []*xmlNode{root}
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			line, _ := dec.InputPos()
+			n := &xmlNode{Name: t.Name.Local, Attrs: map[string]string{}, Line: line}
+			for _, a := range t.Attr {
+				n.Attrs[a.Name.Local] = a.Value
+			}
+			parent := stack[len(stack)-1]
+			parent.Children = append(parent.Children, n)
+			stack = append(stack, n)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			stack[len(stack)-1].Text += string(t)
+		}
+	}
+	if len(stack) > 1 {
+		return nil, fmt.Errorf("element <%s> is not closed", stack[len(stack)-1].Name)
+	}
+	return root.Children, nil
+}
+
+type lintFile struct {
+	Path  string
+	Nodes []*xmlNode
+	Vars  map[string]string
+}
+
+func (f *lintFile) expand(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "$") {
+		return s
+	}
+	for name, value := range f.Vars {
+		s = strings.ReplaceAll(s, "$"+name, value)
+	}
+	return s
+}
+
+// rulesetLinter checks Wazuh rule and decoder XML files offline.
+type rulesetLinter struct {
+	MinID int
+	MaxID int
+
+	files   []*lintFile
+	checked int // files passed to load, including those that are not valid XML
+
+	// definitions from linted files
+	ruleIDs  map[int][]string // id -> "file:line" of non-overwrite definitions
+	groups   map[string]bool
+	decoders map[string]bool
+
+	// definitions from the optional reference ruleset (e.g. a copy of /var/ossec/ruleset)
+	hasReference bool
+	refRuleIDs   map[int]bool
+
+	issues []lintIssue
+}
+
+func newRulesetLinter() *rulesetLinter {
+	return &rulesetLinter{
+		MinID:      customRuleIDMin,
+		MaxID:      customRuleIDMax,
+		ruleIDs:    map[int][]string{},
+		groups:     map[string]bool{},
+		decoders:   map[string]bool{},
+		refRuleIDs: map[int]bool{},
+	}
+}
+
+func (l *rulesetLinter) report(file string, line int, check, severity, format string, a ...interface{}) {
+	l.issues = append(l.issues, lintIssue{
+		File:     file,
+		Line:     line,
+		Check:    check,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// collectXMLFiles expands directories into the .xml files they contain.
+func collectXMLFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		err = filepath.WalkDir(p, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.EqualFold(filepath.Ext(path), ".xml") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// loadReference indexes rule IDs, groups and decoder names of a stock ruleset
+// so references to them can be resolved. Problems in these files are not reported.
+func (l *rulesetLinter) loadReference(paths []string) error {
+	files, err := collectXMLFiles(paths)
+	if err != nil {
+		return err
+	}
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		nodes, err := parseXMLNodes(data)
+		if err != nil {
+			continue
+		}
+		l.hasReference = true
+		f := &lintFile{Path: path, Nodes: nodes, Vars: collectVars(nodes)}
+		forEachRule(f, func(rule *xmlNode, groups []string) {
+			if id, err := strconv.Atoi(rule.attr("id")); err == nil {
+				l.refRuleIDs[id] = true
+			}
+			for _, g := range groups {
+				l.groups[g] = true
+			}
+		})
+		for _, n := range nodes {
+			if n.Name == "decoder" && n.attr("name") != "" {
+				l.decoders[n.attr("name")] = true
+			}
+		}
+	}
+	return nil
+}
+
+// load parses the files to lint and indexes their definitions.
+func (l *rulesetLinter) load(paths []string) error {
+	files, err := collectXMLFiles(paths)
+	if err != nil {
+		return err
+	}
+	for _, path := range files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		l.checked++
+		nodes, err := parseXMLNodes(data)
+		if err != nil {
+			line := 0
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				line = syntaxErr.Line
+			}
+			l.report(path, line, "xml-syntax", lintSeverityError, "%v", err)
+			continue
+		}
+
+		f := &lintFile{Path: path, Nodes: nodes, Vars: collectVars(nodes)}
+		l.files = append(l.files, f)
+
+		forEachRule(f, func(rule *xmlNode, groups []string) {
+			for _, g := range groups {
+				l.groups[g] = true
+			}
+			id, err := strconv.Atoi(rule.attr("id"))
+			if err != nil || strings.EqualFold(rule.attr("overwrite"), "yes") {
+				return
+			}
+			l.ruleIDs[id] = append(l.ruleIDs[id], fmt.Sprintf("%s:%d", path, rule.Line))
+		})
+		for _, n := range nodes {
+			if n.Name == "decoder" && n.attr("name") != "" {
+				l.decoders[n.attr("name")] = true
+			}
+		}
+	}
+	return nil
+}
+
+func collectVars(nodes []*xmlNode) map[string]string {
+	vars := map[string]string{}
+	for _, n := range nodes {
+		if n.Name == "var" && n.attr("name") != "" {
+			vars[n.attr("name")] = strings.TrimSpace(n.Text)
+		}
+	}
+	return vars
+}
+
+// forEachRule calls fn for every <rule> inside a top-level <group name="...">,
+// along with all groups the rule belongs to.
+func forEachRule(f *lintFile, fn func(rule *xmlNode, groups []string)) {
+	for _, container := range f.Nodes {
+		if container.Name != "group" {
+			continue
+		}
+		parentGroups := splitList(container.attr("name"))
+		for _, rule := range container.Children {
+			if rule.Name != "rule" {
+				continue
+			}
+			groups := append([]string{}, parentGroups...)
+			for _, child := range rule.Children {
+				if child.Name == "group" {
+					groups = append(groups, splitList(f.expand(child.Text))...)
+				}
+			}
+			fn(rule, groups)
+		}
+	}
+}
+
+// splitList splits Wazuh comma/space/pipe separated lists, dropping empty items.
+func splitList(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == '|' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+}
+
+// run executes all checks and returns the issues sorted by file and line.
+func (l *rulesetLinter) run() []lintIssue {
+	for id, locations := range l.ruleIDs {
+		if len(locations) > 1 {
+			for _, loc := range locations[1:] {
+				file, line := splitLocation(loc)
+				l.report(file, line, "duplicate-rule-id", lintSeverityError, "rule ID %d is already defined at %s", id, locations[0])
+			}
+		}
+		if l.refRuleIDs[id] {
+			file, line := splitLocation(locations[0])
+			l.report(file, line, "duplicate-rule-id", lintSeverityError, "rule ID %d is already defined in the reference ruleset (use overwrite=\"yes\" to replace it)", id)
+		}
+	}
+
+	for _, f := range l.files {
+		forEachRule(f, func(rule *xmlNode, _ []string) {
+			l.checkRule(f, rule)
+		})
+		for _, n := range f.Nodes {
+			if n.Name == "decoder" {
+				l.checkDecoder(f, n)
+			}
+		}
+	}
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		if l.issues[i].File != l.issues[j].File {
+			return l.issues[i].File < l.issues[j].File
+		}
+		return l.issues[i].Line < l.issues[j].Line
+	})
+	return l.issues
+}
+
+func splitLocation(loc string) (string, int) {
+	i := strings.LastIndex(loc, ":")
+	line, _ := strconv.Atoi(loc[i+1:])
+	return loc[:i], line
+}
+
+var mitreTechniqueID = regexp.MustCompile(`^T\d{4}(\.\d{3})?$`)
+
+// Default pattern type per element when no type="" attribute is set.
+var rulePatternDefaults = map[string]string{
+	"regex":        "osregex",
+	"field":        "osregex",
+	"match":        "osmatch",
+	"srcgeoip":     "osmatch",
+	"dstgeoip":     "osmatch",
+	"user":         "osmatch",
+	"program_name": "osmatch",
+	"hostname":     "osmatch",
+	"url":          "osmatch",
+	"location":     "osmatch",
+	"action":       "osmatch",
+	"id":           "osmatch",
+	"status":       "osmatch",
+	"system_name":  "osmatch",
+	"protocol":     "osmatch",
+	"data":         "osmatch",
+	"extra_data":   "osmatch",
+}
+
+var decoderPatternDefaults = map[string]string{
+	"prematch":     "osregex",
+	"regex":        "osregex",
+	"program_name": "osmatch",
+}
+
+func (l *rulesetLinter) checkRule(f *lintFile, rule *xmlNode) {
+	idStr := rule.attr("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		l.report(f.Path, rule.Line, "invalid-rule-id", lintSeverityError, "rule ID %q is not a valid integer", idStr)
+	} else if !strings.EqualFold(rule.attr("overwrite"), "yes") && (id < l.MinID || id > l.MaxID) {
+		l.report(f.Path, rule.Line, "rule-id-range", lintSeverityError, "rule ID %d is outside the custom range %d-%d", id, l.MinID, l.MaxID)
+	}
+
+	l.checkIntRange(f, rule, "level", "invalid-level", 0, 16, true)
+	hasFrequency := l.checkIntRange(f, rule, "frequency", "invalid-frequency", 2, 9999, false)
+	hasTimeframe := l.checkIntRange(f, rule, "timeframe", "invalid-timeframe", 1, 99999, false)
+
+	correlated := hasFrequency || hasTimeframe
+	for _, child := range rule.Children {
+		value := f.expand(child.Text)
+		switch child.Name {
+		case "if_sid":
+			l.checkRuleRefs(f, child, value, "dangling-if-sid")
+		case "if_matched_sid":
+			correlated = true
+			l.checkRuleRefs(f, child, value, "dangling-if-matched-sid")
+		case "if_group", "if_matched_group":
+			if child.Name == "if_matched_group" {
+				correlated = true
+			}
+			for _, g := range splitList(value) {
+				if !l.groups[g] && l.hasReference {
+					l.report(f.Path, child.Line, "dangling-if-group", lintSeverityError, "%s references group %q, which no rule belongs to", child.Name, g)
+				}
+			}
+		case "decoded_as":
+			if value != "" && !l.decoders[value] && l.hasReference {
+				l.report(f.Path, child.Line, "unknown-decoder", lintSeverityError, "decoded_as references unknown decoder %q", value)
+			}
+		case "mitre":
+			for _, m := range child.Children {
+				if m.Name != "id" {
+					continue
+				}
+				if mid := strings.TrimSpace(m.Text); !mitreTechniqueID.MatchString(mid) {
+					l.report(f.Path, m.Line, "invalid-mitre-id", lintSeverityError, "malformed MITRE technique ID %q", mid)
+				}
+			}
+		default:
+			if def, ok := rulePatternDefaults[child.Name]; ok {
+				l.checkPattern(f, child, value, def)
+			}
+		}
+	}
+
+	if correlated && (!hasFrequency || !hasTimeframe) {
+		l.report(f.Path, rule.Line, "missing-timeframe", lintSeverityError, "correlation rule %s must set both frequency and timeframe", idStr)
+	}
+}
+
+func (l *rulesetLinter) checkDecoder(f *lintFile, decoder *xmlNode) {
+	for _, child := range decoder.Children {
+		value := f.expand(child.Text)
+		switch child.Name {
+		case "parent":
+			if value != "" && !l.decoders[value] && l.hasReference {
+				l.report(f.Path, child.Line, "unknown-decoder-parent", lintSeverityError, "decoder %q has unknown parent %q", decoder.attr("name"), value)
+			}
+		default:
+			if def, ok := decoderPatternDefaults[child.Name]; ok {
+				l.checkPattern(f, child, value, def)
+			}
+		}
+	}
+}
+
+// checkIntRange validates an optional integer attribute and reports whether it is set.
+func (l *rulesetLinter) checkIntRange(f *lintFile, n *xmlNode, attr, check string, min, max int, required bool) bool {
+	raw, ok := n.Attrs[attr]
+	if !ok {
+		if required {
+			l.report(f.Path, n.Line, check, lintSeverityError, "rule %s has no %s", n.attr("id"), attr)
+		}
+		return false
+	}
+	v, err := strconv.Atoi(f.expand(raw))
+	if err != nil || v < min || v > max {
+		l.report(f.Path, n.Line, check, lintSeverityError, "rule %s has invalid %s %q (expected %d-%d)", n.attr("id"), attr, raw, min, max)
+	}
+	return true
+}
+
+func (l *rulesetLinter) checkRuleRefs(f *lintFile, n *xmlNode, value, check string) {
+	for _, ref := range splitList(value) {
+		id, err := strconv.Atoi(ref)
+		if err != nil {
+			l.report(f.Path, n.Line, check, lintSeverityError, "%s contains non-numeric rule ID %q", n.Name, ref)
+			continue
+		}
+		if len(l.ruleIDs[id]) > 0 || l.refRuleIDs[id] {
+			continue
+		}
+		// Without a reference ruleset only references into the custom range can be verified.
+		if l.hasReference || (id >= l.MinID && id <= l.MaxID) {
+			l.report(f.Path, n.Line, check, lintSeverityError, "%s references undefined rule %d", n.Name, id)
+		}
+	}
+}
+
+func (l *rulesetLinter) checkPattern(f *lintFile, n *xmlNode, value, defaultType string) {
+	patternType := strings.ToLower(n.attr("type"))
+	if patternType == "" {
+		patternType = defaultType
+	}
+	switch patternType {
+	case "pcre2":
+		if err := validatePCRE2(value); err != nil {
+			l.report(f.Path, n.Line, "invalid-pcre2", lintSeverityError, "<%s> is not valid PCRE2: %v", n.Name, err)
+		}
+	case "osregex":
+		if err := validateOSRegex(value); err != nil {
+			l.report(f.Path, n.Line, "invalid-regex", lintSeverityError, "<%s> is not valid OS_Regex: %v", n.Name, err)
+		}
+	}
+}
+
+// osRegexEscapes are the characters that may follow a backslash in OS_Regex.
+const osRegexEscapes = `wWdDsSpt.$()\|<>!+*^`
+
+// validateOSRegex checks the subset of the OS_Regex grammar that Wazuh rejects
+// at load time: unknown escapes and unbalanced or nested groups.
+func validateOSRegex(p string) error {
+	depth := 0
+	for i := 0; i < len(p); i++ {
+		switch p[i] {
+		case '\\':
+			if i+1 >= len(p) {
+				return errors.New("trailing backslash")
+			}
+			i++
+			if !strings.ContainsRune(osRegexEscapes, rune(p[i])) {
+				return fmt.Errorf("unsupported escape \\%c at offset %d", p[i], i-1)
+			}
+		case '(':
+			if depth > 0 {
+				return fmt.Errorf("nested group at offset %d is not supported", i)
+			}
+			depth++
+		case ')':
+			if depth == 0 {
+				return fmt.Errorf("unbalanced ')' at offset %d", i)
+			}
+			depth--
+		}
+	}
+	if depth != 0 {
+		return errors.New("unbalanced '('")
+	}
+	return nil
+}
+
+// pcre2Unverifiable matches PCRE2 constructs the Go regexp parser does not
+// understand (lookaround, backreferences, atomic groups, possessive quantifiers, ...).
+var pcre2Unverifiable = regexp.MustCompile(`\(\?[=!>|]|\(\?<[=!]|\(\*|\(\?R|\(\?\d|\\[1-9gkKhHRXZ]|[*+?}]\+`)
+
+// validatePCRE2 is a best-effort syntax check using the Go (RE2) parser.
+// Patterns using PCRE-only features are accepted without validation.
+func validatePCRE2(p string) error {
+	if pcre2Unverifiable.MatchString(p) {
+		return nil
+	}
+	_, err := syntax.Parse(p, syntax.Perl)
+	return err
+}
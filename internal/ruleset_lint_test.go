@@ -0,0 +1,345 @@
+package internal
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// writeLintFixtures writes name -> content into a new directory and returns it.
+func writeLintFixtures(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func lintChecksFound(issues []lintIssue) []string {
+	checks := make([]string, 0, len(issues))
+	for _, i := range issues {
+		checks = append(checks, i.Check)
+	}
+	sort.Strings(checks)
+	return checks
+}
+
+const lintReferenceRuleset = `
+<group name="syslog,sshd,">
+  <rule id="5700" level="0" noalert="1">
+    <decoded_as>sshd</decoded_as>
+    <description>SSHD messages grouped.</description>
+  </rule>
+  <rule id="5716" level="5">
+    <if_sid>5700</if_sid>
+    <match>^Failed password</match>
+    <description>sshd: authentication failed.</description>
+  </rule>
+</group>
+<decoder name="sshd">
+  <program_name>^sshd</program_name>
+</decoder>
+`
+
+func TestRulesetLinterChecks(t *testing.T) {
+	cases := []struct {
+		name          string
+		content       string
+		withReference bool
+		want          []string
+	}{
+		{
+			name: "valid rule",
+			content: `
+<group name="local,">
+  <rule id="100001" level="5">
+    <if_sid>5716</if_sid>
+    <match>Failed password</match>
+    <regex type="pcre2">^user (\w+)$</regex>
+    <mitre><id>T1110.001</id></mitre>
+    <description>Custom sshd rule.</description>
+  </rule>
+</group>`,
+		},
+		{
+			name:    "xml-syntax",
+			content: `<group name="local,"><rule id="100001" level="3"></group>`,
+			want:    []string{"xml-syntax"},
+		},
+		{
+			name: "invalid-rule-id",
+			content: `
+<group name="local,">
+  <rule id="abc" level="3"><description>x</description></rule>
+</group>`,
+			want: []string{"invalid-rule-id"},
+		},
+		{
+			name: "duplicate-rule-id in linted files",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><description>a</description></rule>
+  <rule id="100001" level="3"><description>b</description></rule>
+</group>`,
+			want: []string{"duplicate-rule-id"},
+		},
+		{
+			name: "duplicate-rule-id against reference",
+			content: `
+<group name="local,">
+  <rule id="5716" level="3"><description>x</description></rule>
+</group>`,
+			withReference: true,
+			want:          []string{"duplicate-rule-id", "rule-id-range"},
+		},
+		{
+			name: "overwrite replaces a stock rule",
+			content: `
+<group name="local,">
+  <rule id="5716" level="3" overwrite="yes"><description>x</description></rule>
+</group>`,
+			withReference: true,
+		},
+		{
+			name: "rule-id-range",
+			content: `
+<group name="local,">
+  <rule id="500" level="3"><description>x</description></rule>
+</group>`,
+			want: []string{"rule-id-range"},
+		},
+		{
+			name: "dangling-if-sid",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><if_sid>100999, abc</if_sid><description>x</description></rule>
+</group>`,
+			want: []string{"dangling-if-sid", "dangling-if-sid"},
+		},
+		{
+			name: "dangling-if-sid through a variable",
+			content: `
+<var name="PARENT">100999</var>
+<group name="local,">
+  <rule id="100001" level="3"><if_sid>$PARENT</if_sid><description>x</description></rule>
+</group>`,
+			want: []string{"dangling-if-sid"},
+		},
+		{
+			name: "stock if_sid is only verified with a reference",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><if_sid>5999</if_sid><description>x</description></rule>
+</group>`,
+		},
+		{
+			name: "stock if_sid verified against reference",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><if_sid>5999</if_sid><description>x</description></rule>
+</group>`,
+			withReference: true,
+			want:          []string{"dangling-if-sid"},
+		},
+		{
+			name: "dangling-if-matched-sid",
+			content: `
+<group name="local,">
+  <rule id="100001" level="10" frequency="4" timeframe="60">
+    <if_matched_sid>100999</if_matched_sid>
+    <description>x</description>
+  </rule>
+</group>`,
+			want: []string{"dangling-if-matched-sid"},
+		},
+		{
+			name: "dangling-if-group",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><if_group>sshd</if_group><description>x</description></rule>
+  <rule id="100002" level="3"><if_group>nope</if_group><description>x</description></rule>
+</group>`,
+			withReference: true,
+			want:          []string{"dangling-if-group"},
+		},
+		{
+			name: "if_group is skipped without a reference",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><if_group>nope</if_group><description>x</description></rule>
+</group>`,
+		},
+		{
+			name: "unknown-decoder",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><decoded_as>sshd</decoded_as><description>x</description></rule>
+  <rule id="100002" level="3"><decoded_as>nope</decoded_as><description>x</description></rule>
+</group>`,
+			withReference: true,
+			want:          []string{"unknown-decoder"},
+		},
+		{
+			name: "unknown-decoder-parent",
+			content: `
+<decoder name="sshd-custom"><parent>sshd</parent><prematch>^Custom</prematch></decoder>
+<decoder name="other-custom"><parent>nope</parent><prematch>^Other</prematch></decoder>`,
+			withReference: true,
+			want:          []string{"unknown-decoder-parent"},
+		},
+		{
+			name:    "decoder parent is skipped without a reference",
+			content: `<decoder name="other-custom"><parent>nope</parent></decoder>`,
+		},
+		{
+			name: "invalid-regex",
+			content: `
+<decoder name="custom"><prematch>^\q</prematch><regex>^(a(b))</regex></decoder>
+<group name="local,">
+  <rule id="100001" level="3"><regex>(unbalanced</regex><match>(not a regex</match><description>x</description></rule>
+</group>`,
+			want: []string{"invalid-regex", "invalid-regex", "invalid-regex"},
+		},
+		{
+			name: "invalid-pcre2",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3">
+    <regex type="pcre2">(unclosed</regex>
+    <match type="pcre2">(?=lookahead)is not verified</match>
+    <description>x</description>
+  </rule>
+</group>`,
+			want: []string{"invalid-pcre2"},
+		},
+		{
+			name: "invalid-level",
+			content: `
+<group name="local,">
+  <rule id="100001" level="17"><description>x</description></rule>
+  <rule id="100002"><description>x</description></rule>
+</group>`,
+			want: []string{"invalid-level", "invalid-level"},
+		},
+		{
+			name: "invalid-frequency",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3" frequency="1" timeframe="60"><if_matched_sid>100002</if_matched_sid><description>x</description></rule>
+  <rule id="100002" level="3"><description>x</description></rule>
+</group>`,
+			want: []string{"invalid-frequency"},
+		},
+		{
+			name: "invalid-timeframe",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3" frequency="2" timeframe="0"><if_matched_sid>100002</if_matched_sid><description>x</description></rule>
+  <rule id="100002" level="3"><description>x</description></rule>
+</group>`,
+			want: []string{"invalid-timeframe"},
+		},
+		{
+			name: "missing-timeframe",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3" frequency="4"><if_sid>100002</if_sid><description>x</description></rule>
+  <rule id="100002" level="3"><description>x</description></rule>
+  <rule id="100003" level="3"><if_matched_group>local</if_matched_group><description>x</description></rule>
+</group>`,
+			want: []string{"missing-timeframe", "missing-timeframe"},
+		},
+		{
+			name: "invalid-mitre-id",
+			content: `
+<group name="local,">
+  <rule id="100001" level="3"><mitre><id>T1110</id><id>T11x</id></mitre><description>x</description></rule>
+</group>`,
+			want: []string{"invalid-mitre-id"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			linter := newRulesetLinter()
+			if tc.withReference {
+				ref := writeLintFixtures(t, map[string]string{"0095-sshd_rules.xml": lintReferenceRuleset})
+				if err := linter.loadReference([]string{ref}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			dir := writeLintFixtures(t, map[string]string{"local.xml": tc.content})
+			if err := linter.load([]string{dir}); err != nil {
+				t.Fatal(err)
+			}
+			issues := linter.run()
+
+			got := strings.Join(lintChecksFound(issues), ",")
+			want := append([]string{}, tc.want...)
+			sort.Strings(want)
+			if got != strings.Join(want, ",") {
+				t.Errorf("checks = [%s], want [%s]\nissues: %+v", got, strings.Join(want, ","), issues)
+			}
+		})
+	}
+}
+
+func TestRulesetLinterCustomRange(t *testing.T) {
+	dir := writeLintFixtures(t, map[string]string{"local.xml": `
+<group name="local,">
+  <rule id="200001" level="3"><description>x</description></rule>
+</group>`})
+	linter := newRulesetLinter()
+	linter.MinID, linter.MaxID = 200000, 200999
+	if err := linter.load([]string{dir}); err != nil {
+		t.Fatal(err)
+	}
+	if issues := linter.run(); len(issues) != 0 {
+		t.Errorf("unexpected issues: %+v", issues)
+	}
+}
+
+func TestValidateOSRegex(t *testing.T) {
+	cases := map[string]bool{
+		`^Failed password for (\S+) from (\S+)`: true,
+		`\d+\.\d+\.\d+\.\d+`:                    true,
+		`^user \w+$|^admin`:                     true,
+		`trailing\`:                             false,
+		`\q`:                                    false,
+		`((nested))`:                            false,
+		`unbalanced)`:                           false,
+		`(unbalanced`:                           false,
+	}
+	for pattern, valid := range cases {
+		if err := validateOSRegex(pattern); (err == nil) != valid {
+			t.Errorf("validateOSRegex(%q) = %v, want valid=%v", pattern, err, valid)
+		}
+	}
+}
+
+func TestRunLintSummary(t *testing.T) {
+	dir := writeLintFixtures(t, map[string]string{
+		"broken.xml": `<group name="local,"><rule id="100001" level="3"></group>`,
+		"ok.xml":     `<group name="local,"><rule id="100002" level="3"><description>x</description></rule></group>`,
+	})
+
+	var out bytes.Buffer
+	if err := runLint([]string{dir}, &out); err == nil {
+		t.Fatal("expected an error for the broken file")
+	}
+	for _, want := range []string{
+		"[xml-syntax]",
+		"note: no --ruleset given",
+		"2 file(s) checked, 1 error(s)",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output does not contain %q:\n%s", want, out.String())
+		}
+	}
+}
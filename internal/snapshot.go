@@ -0,0 +1,324 @@
+package internal
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// snapshotFormatVersion is bumped whenever the on-disk layout of a backup changes.
+const snapshotFormatVersion = 1
+
+const snapshotManifestFile = "manifest.json"
+
+// Kinds of objects stored in a snapshot.
+const (
+	snapshotKindManagerConfiguration = "manager_configuration"
+	snapshotKindNodeConfiguration    = "node_configuration"
+	snapshotKindGroupConfiguration   = "group_configuration"
+	snapshotKindRuleFile             = "rule_file"
+	snapshotKindDecoderFile          = "decoder_file"
+	snapshotKindCDBList              = "cdb_list"
+	snapshotKindSecurityUsers        = "security_users"
+	snapshotKindSecurityRoles        = "security_roles"
+	snapshotKindSecurityPolicies     = "security_policies"
+	snapshotKindSecurityRules        = "security_rules"
+)
+
+// snapshotSecurityEndpoints maps RBAC snapshot kinds to their list endpoint.
+var snapshotSecurityEndpoints = map[string]string{
+	snapshotKindSecurityUsers:    "security/users",
+	snapshotKindSecurityRoles:    "security/roles",
+	snapshotKindSecurityPolicies: "security/policies",
+	snapshotKindSecurityRules:    "security/rules",
+}
+
+type snapshotManifest struct {
+	FormatVersion int            `json:"format_version"`
+	CreatedAt     string         `json:"created_at"`
+	Endpoint      string         `json:"endpoint"`
+	WazuhVersion  string         `json:"wazuh_version,omitempty"`
+	Files         []snapshotFile `json:"files"`
+}
+
+type snapshotFile struct {
+	Path            string `json:"path"`
+	Kind            string `json:"kind"`
+	Name            string `json:"name,omitempty"`
+	RelativeDirname string `json:"relative_dirname,omitempty"`
+	Size            int    `json:"size"`
+	SHA256          string `json:"sha256"`
+}
+
+// snapshot is an in-memory backup: a manifest plus the content of every file it lists.
+type snapshot struct {
+	Manifest snapshotManifest
+	Data     map[string][]byte
+}
+
+func newSnapshot(endpoint, wazuhVersion string) *snapshot {
+	return &snapshot{
+		Manifest: snapshotManifest{
+			FormatVersion: snapshotFormatVersion,
+			CreatedAt:     time.Now().UTC().Format(time.RFC3339),
+			Endpoint:      endpoint,
+			WazuhVersion:  wazuhVersion,
+		},
+		Data: map[string][]byte{},
+	}
+}
+
+func (s *snapshot) add(f snapshotFile, data []byte) {
+	f.Size = len(data)
+	f.SHA256 = sha256Hex(data)
+	s.Manifest.Files = append(s.Manifest.Files, f)
+	s.Data[f.Path] = data
+}
+
+// verify checks that every file listed in the manifest is present and matches its checksum.
+func (s *snapshot) verify() error {
+	if s.Manifest.FormatVersion != snapshotFormatVersion {
+		return fmt.Errorf("unsupported snapshot format version %d (expected %d)", s.Manifest.FormatVersion, snapshotFormatVersion)
+	}
+	for _, f := range s.Manifest.Files {
+		data, ok := s.Data[f.Path]
+		if !ok {
+			return fmt.Errorf("snapshot file %q listed in manifest is missing", f.Path)
+		}
+		if sum := sha256Hex(data); sum != f.SHA256 {
+			return fmt.Errorf("checksum mismatch for %q: manifest %s, actual %s", f.Path, f.SHA256, sum)
+		}
+	}
+	return nil
+}
+
+func (s *snapshot) manifestJSON() ([]byte, error) {
+	data, err := json.MarshalIndent(s.Manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// write stores the snapshot as a gzipped tarball when dest ends with .tar.gz/.tgz,
+// otherwise as a directory tree.
+func (s *snapshot) write(dest string) error {
+	if isTarballPath(dest) {
+		return s.writeTarball(dest)
+	}
+	return s.writeDir(dest)
+}
+
+func (s *snapshot) writeDir(dir string) error {
+	if entries, err := os.ReadDir(dir); err == nil && len(entries) > 0 {
+		return fmt.Errorf("output directory %q is not empty", dir)
+	}
+
+	manifest, err := s.manifestJSON()
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]byte{snapshotManifestFile: manifest}
+	for p, data := range s.Data {
+		files[p] = data
+	}
+
+	for p, data := range files {
+		target := filepath.Join(dir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, data, 0o600); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *snapshot) writeTarball(dest string) error {
+	manifest, err := s.manifestJSON()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	writeEntry := func(name string, data []byte) error {
+		hdr := &tar.Header{
+			Name:    name,
+			Mode:    0o600,
+			Size:    int64(len(data)),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	if err := writeEntry(snapshotManifestFile, manifest); err != nil {
+		return err
+	}
+	for _, f := range s.Manifest.Files {
+		if err := writeEntry(f.Path, s.Data[f.Path]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// readSnapshot loads a snapshot written by snapshot.write from a directory or tarball.
+func readSnapshot(src string) (*snapshot, error) {
+	files := map[string][]byte{}
+
+	if isTarballPath(src) {
+		f, err := os.Open(src)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		tr := tar.NewReader(gz)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if hdr.Typeflag != tar.TypeReg {
+				continue
+			}
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			files[path.Clean(hdr.Name)] = data
+		}
+	} else {
+		err := filepath.WalkDir(src, func(p string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(src, p)
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(rel)] = data
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	manifest, ok := files[snapshotManifestFile]
+	if !ok {
+		return nil, fmt.Errorf("%s not found in %q", snapshotManifestFile, src)
+	}
+	delete(files, snapshotManifestFile)
+
+	s := &snapshot{Data: files}
+	if err := json.Unmarshal(manifest, &s.Manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", snapshotManifestFile, err)
+	}
+	return s, nil
+}
+
+// fetchSnapshotFile downloads the current server-side content of a snapshot entry.
+func fetchSnapshotFile(ctx context.Context, c *APIClient, f snapshotFile) ([]byte, error) {
+	raw := url.Values{}
+	raw.Set("raw", "true")
+
+	name := url.PathEscape(f.Name)
+
+	switch f.Kind {
+	case snapshotKindManagerConfiguration:
+		return c.doRawRequest(ctx, http.MethodGet, "manager/configuration", raw, nil, "")
+	case snapshotKindNodeConfiguration:
+		return c.doRawRequest(ctx, http.MethodGet, "cluster/"+name+"/configuration", raw, nil, "")
+	case snapshotKindGroupConfiguration:
+		return c.doRawRequest(ctx, http.MethodGet, "groups/"+name+"/files/agent.conf", raw, nil, "")
+	case snapshotKindRuleFile, snapshotKindDecoderFile:
+		if f.RelativeDirname != "" {
+			raw.Set("relative_dirname", f.RelativeDirname)
+		}
+		base := "rules"
+		if f.Kind == snapshotKindDecoderFile {
+			base = "decoders"
+		}
+		return c.doRawRequest(ctx, http.MethodGet, base+"/files/"+name, raw, nil, "")
+	case snapshotKindCDBList:
+		return c.doRawRequest(ctx, http.MethodGet, "lists/files/"+name, raw, nil, "")
+	}
+
+	if endpoint, ok := snapshotSecurityEndpoints[f.Kind]; ok {
+		items, err := c.listAffectedItems(ctx, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		if items == nil {
+			items = []json.RawMessage{}
+		}
+		data, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+
+	return nil, fmt.Errorf("unknown snapshot kind %q", f.Kind)
+}
+
+func isTarballPath(p string) bool {
+	return strings.HasSuffix(p, ".tar.gz") || strings.HasSuffix(p, ".tgz")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// sameContent compares two file bodies ignoring trailing whitespace differences
+// introduced by the API when returning raw files.
+func sameContent(a, b []byte) bool {
+	return bytes.Equal(bytes.TrimRight(a, " \r\n\t"), bytes.TrimRight(b, " \r\n\t"))
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testSnapshot() *snapshot {
+	snap := newSnapshot("https://wazuh.example.com:55000", "v4.14.0")
+	snap.add(snapshotFile{Path: "manager/ossec.conf", Kind: snapshotKindManagerConfiguration}, []byte("<ossec_config/>\n"))
+	snap.add(snapshotFile{Path: "groups/linux/agent.conf", Kind: snapshotKindGroupConfiguration, Name: "linux"}, []byte("<agent_config/>\n"))
+	snap.add(snapshotFile{
+		Path:            "etc/lists/amazon/aws-sources",
+		Kind:            snapshotKindCDBList,
+		Name:            "aws-sources",
+		RelativeDirname: "etc/lists/amazon",
+	}, []byte("s3.amazonaws.com:\n"))
+	snap.add(snapshotFile{Path: "security/roles.json", Kind: snapshotKindSecurityRoles}, []byte("[]\n"))
+	return snap
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	for _, dest := range []string{"backup", "backup.tar.gz", "backup.tgz"} {
+		t.Run(dest, func(t *testing.T) {
+			want := testSnapshot()
+			path := filepath.Join(t.TempDir(), dest)
+			if err := want.write(path); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := readSnapshot(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := got.verify(); err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if got.Manifest.Endpoint != want.Manifest.Endpoint || got.Manifest.WazuhVersion != want.Manifest.WazuhVersion {
+				t.Errorf("manifest = %+v, want %+v", got.Manifest, want.Manifest)
+			}
+			if len(got.Manifest.Files) != len(want.Manifest.Files) {
+				t.Fatalf("got %d files, want %d", len(got.Manifest.Files), len(want.Manifest.Files))
+			}
+			for i, f := range want.Manifest.Files {
+				if got.Manifest.Files[i] != f {
+					t.Errorf("file %d = %+v, want %+v", i, got.Manifest.Files[i], f)
+				}
+				if string(got.Data[f.Path]) != string(want.Data[f.Path]) {
+					t.Errorf("%s = %q, want %q", f.Path, got.Data[f.Path], want.Data[f.Path])
+				}
+			}
+			if len(got.Data) != len(want.Data) {
+				t.Errorf("got %d data entries, want %d", len(got.Data), len(want.Data))
+			}
+		})
+	}
+}
+
+func TestSnapshotWriteRefusesExistingOutput(t *testing.T) {
+	dir := t.TempDir()
+	if err := testSnapshot().write(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := testSnapshot().write(dir); err == nil {
+		t.Error("expected an error when writing into a non-empty directory")
+	}
+
+	tarball := filepath.Join(t.TempDir(), "backup.tar.gz")
+	if err := testSnapshot().write(tarball); err != nil {
+		t.Fatal(err)
+	}
+	if err := testSnapshot().write(tarball); err == nil {
+		t.Error("expected an error when overwriting a tarball")
+	}
+}
+
+func TestSnapshotVerify(t *testing.T) {
+	cases := map[string]struct {
+		modify func(*snapshot)
+		want   string
+	}{
+		"checksum mismatch": {
+			modify: func(s *snapshot) { s.Data["manager/ossec.conf"] = []byte("<ossec_config>tampered</ossec_config>\n") },
+			want:   `checksum mismatch for "manager/ossec.conf"`,
+		},
+		"missing file": {
+			modify: func(s *snapshot) { delete(s.Data, "groups/linux/agent.conf") },
+			want:   `"groups/linux/agent.conf" listed in manifest is missing`,
+		},
+		"format version": {
+			modify: func(s *snapshot) { s.Manifest.FormatVersion = snapshotFormatVersion + 1 },
+			want:   "unsupported snapshot format version",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			snap := testSnapshot()
+			tc.modify(snap)
+			err := snap.verify()
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("verify() = %v, want an error containing %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestSnapshotVerifyAfterRead(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "backup")
+	if err := testSnapshot().write(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "manager", "ossec.conf"), []byte("<ossec_config>edited</ossec_config>\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	snap, err := readSnapshot(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := snap.verify(); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("verify() = %v, want a checksum mismatch", err)
+	}
+}
+
+func TestCheckSnapshotName(t *testing.T) {
+	cases := map[string]bool{
+		"node01":           true,
+		"local_rules.xml":  true,
+		"audit-keys":       true,
+		"":                 false,
+		"..":               false,
+		"a..b":             false,
+		"../etc":           false,
+		"nodes/evil":       false,
+		`nodes\evil`:       false,
+		"/etc/ossec.conf":  false,
+		"node01/../../tmp": false,
+	}
+	for name, valid := range cases {
+		if err := checkSnapshotName(name); (err == nil) != valid {
+			t.Errorf("checkSnapshotName(%q) = %v, want valid=%v", name, err, valid)
+		}
+	}
+}
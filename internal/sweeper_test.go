@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Sweepers remove objects left behind by aborted e2e-tests runs. Run them with:
+//
+//	WAZUH_ENDPOINT=... WAZUH_USER=... WAZUH_PASSWORD=... go test ./internal -v -sweep=local
+//
+// Only objects whose name starts with sweepPrefix (override with
+// WAZUH_SWEEP_PREFIX) are deleted.
+const sweepPrefix = "tf-test-"
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("wazuh_group", &resource.Sweeper{
+		Name: "wazuh_group",
+		F:    sweepGroups,
+	})
+	resource.AddTestSweepers("wazuh_user", &resource.Sweeper{
+		Name: "wazuh_user",
+		F:    sweepUsers,
+	})
+	resource.AddTestSweepers("wazuh_role", &resource.Sweeper{
+		Name: "wazuh_role",
+		F:    sweepRoles,
+	})
+	resource.AddTestSweepers("wazuh_policy", &resource.Sweeper{
+		Name: "wazuh_policy",
+		F:    sweepPolicies,
+	})
+	resource.AddTestSweepers("wazuh_security_rule", &resource.Sweeper{
+		Name: "wazuh_security_rule",
+		F:    sweepSecurityRules,
+	})
+	resource.AddTestSweepers("wazuh_cdb_list", &resource.Sweeper{
+		Name: "wazuh_cdb_list",
+		F:    sweepCDBLists,
+	})
+	resource.AddTestSweepers("wazuh_rule", &resource.Sweeper{
+		Name: "wazuh_rule",
+		F:    sweepRuleFiles,
+	})
+}
+
+// sweeperClient builds an API client from the WAZUH_* environment variables.
+// The region argument of the sweeper framework is not used.
+func sweeperClient() (*APIClient, string, error) {
+	endpoint := os.Getenv("WAZUH_ENDPOINT")
+	user := os.Getenv("WAZUH_USER")
+	password := os.Getenv("WAZUH_PASSWORD")
+	if endpoint == "" || user == "" || password == "" {
+		return nil, "", fmt.Errorf("WAZUH_ENDPOINT, WAZUH_USER and WAZUH_PASSWORD must be set to run sweepers")
+	}
+	skipSSL, _ := strconv.ParseBool(os.Getenv("WAZUH_SKIP_SSL_VERIFY"))
+
+	prefix := sweepPrefix
+	if v := os.Getenv("WAZUH_SWEEP_PREFIX"); v != "" {
+		prefix = v
+	}
+
+	client, err := sharedAPIClient(endpoint, user, password, skipSSL)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, prefix, nil
+}
+
+// sweepListed lists path, keeps items whose nameField starts with prefix and
+// deletes them in one call by passing their idField values as idsParam.
+func sweepListed(path, nameField, idField, idsParam string) error {
+	client, prefix, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	items, err := client.listAffectedItems(ctx, path, url.Values{"search": {prefix}})
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %w", path, err)
+	}
+
+	var ids, names []string
+	for _, raw := range items {
+		var item map[string]interface{}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return fmt.Errorf("failed to parse %s item: %w", path, err)
+		}
+		name, _ := item[nameField].(string)
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		ids = append(ids, fmt.Sprint(item[idField]))
+		names = append(names, name)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Sweeping %s: %s", path, strings.Join(names, ", "))
+	q := url.Values{idsParam: {strings.Join(ids, ",")}}
+	if err := client.doJSONRequest(ctx, http.MethodDelete, path, q, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", path, strings.Join(names, ", "), err)
+	}
+	return nil
+}
+
+// sweepFiles deletes files below relativeDirname of a ruleset file endpoint
+// (rules/files, lists/files) whose filename starts with the prefix.
+func sweepFiles(filesPath, relativeDirname string) error {
+	client, prefix, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	items, err := client.listAffectedItems(ctx, filesPath, url.Values{"relative_dirname": {relativeDirname}})
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %w", filesPath, err)
+	}
+
+	for _, raw := range items {
+		var item struct {
+			Filename string `json:"filename"`
+		}
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return fmt.Errorf("failed to parse %s item: %w", filesPath, err)
+		}
+		if !strings.HasPrefix(item.Filename, prefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Sweeping %s/%s", filesPath, item.Filename)
+		path := fmt.Sprintf("%s/%s", filesPath, url.PathEscape(item.Filename))
+		if err := client.doJSONRequest(ctx, http.MethodDelete, path, nil, nil, nil); err != nil && !isNotFound(err) {
+			return fmt.Errorf("failed to delete %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+func sweepGroups(region string) error {
+	return sweepListed("groups", "name", "name", "groups_list")
+}
+
+func sweepUsers(region string) error {
+	return sweepListed("security/users", "username", "id", "user_ids")
+}
+
+func sweepRoles(region string) error {
+	return sweepListed("security/roles", "name", "id", "role_ids")
+}
+
+func sweepPolicies(region string) error {
+	return sweepListed("security/policies", "name", "id", "policy_ids")
+}
+
+func sweepSecurityRules(region string) error {
+	return sweepListed("security/rules", "name", "id", "rule_ids")
+}
+
+func sweepCDBLists(region string) error {
+	return sweepFiles("lists/files", "etc/lists")
+}
+
+func sweepRuleFiles(region string) error {
+	return sweepFiles("rules/files", "etc/rules")
+}
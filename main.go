@@ -1,20 +1,65 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"log"
+	"os"
 
 	"github.com/grulicht/terraform-provider-wazuh/internal"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
+// version is set by goreleaser.
+var version = "dev"
+
 func main() {
+	// Subcommands (e.g. `terraform-provider-wazuh backup --out dir`) reuse the
+	// provider's API client. Terraform itself never passes positional arguments.
+	if len(os.Args) > 1 {
+		if cmd, ok := internal.Commands[os.Args[1]]; ok {
+			if err := cmd.Run(os.Args[2:], os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	var debugMode bool
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] | <command> [flags]\n\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprintln(flag.CommandLine.Output())
+		internal.CommandUsage(flag.CommandLine.Output())
+	}
 	flag.Parse()
 
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: internal.Provider,
-		Debug:        debugMode,
-		ProviderAddr: "registry.terraform.io/grulicht/wazuh",
-	})
+	// The SDKv2 provider serves the existing resources, the framework provider
+	// serves provider-defined functions. Both share the same provider block.
+	ctx := context.Background()
+	providers := []func() tfprotov5.ProviderServer{
+		internal.Provider().GRPCProvider,
+		providerserver.NewProtocol5(internal.FrameworkProvider(version)()),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	err = tf5server.Serve("registry.terraform.io/grulicht/wazuh", muxServer.ProviderServer, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }